@@ -0,0 +1,168 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jfreymuth/oggvorbis"
+)
+
+// Info describes an Ogg stream, as read from its headers.
+type Info struct {
+	Codec      string
+	Channels   int
+	SampleRate int
+	Duration   time.Duration
+	Vendor     string
+}
+
+func (i Info) String() string {
+	channels := fmt.Sprintf("%d channels", i.Channels)
+	switch i.Channels {
+	case 1:
+		channels = "mono"
+	case 2:
+		channels = "stereo"
+	}
+	return fmt.Sprintf("%s, %d Hz, %s, %.2fs", i.Codec, i.SampleRate, channels, i.Duration.Seconds())
+}
+
+// PCM is decoded audio, with the channels' samples interleaved and scaled
+// to -1..1.
+type PCM struct {
+	Channels   int
+	SampleRate int
+	Samples    []float32
+}
+
+// Duration is how long the audio plays for.
+func (p PCM) Duration() time.Duration {
+	if p.Channels <= 0 {
+		return 0
+	}
+	return samplesToDuration(int64(len(p.Samples)/p.Channels), p.SampleRate)
+}
+
+// Decode decodes an Ogg Vorbis stream to PCM. Opus streams can be probed
+// but not decoded.
+func Decode(r io.Reader) (PCM, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return PCM{}, err
+	}
+	info, err := Probe(bytes.NewReader(data))
+	if err != nil {
+		return PCM{}, err
+	}
+	if info.Codec != "Vorbis" {
+		return PCM{}, fmt.Errorf("decoding %s isn't supported", info.Codec)
+	}
+
+	samples, format, err := oggvorbis.ReadAll(bytes.NewReader(data))
+	if err != nil {
+		return PCM{}, fmt.Errorf("failed to decode vorbis audio: %w", err)
+	}
+	return PCM{Channels: format.Channels, SampleRate: format.SampleRate, Samples: samples}, nil
+}
+
+// Probe reads an Ogg Vorbis or Ogg Opus stream and returns its properties
+// from the Ogg pages and the codec's identification and comment headers,
+// without decoding any audio.
+func Probe(r io.Reader) (Info, error) {
+	stream, err := readStream(r)
+	if err != nil {
+		return Info{}, err
+	}
+	if len(stream.Packets) == 0 {
+		return Info{}, errors.New("ogg stream has no packets")
+	}
+
+	first := stream.Packets[0]
+	switch {
+	case bytes.HasPrefix(first, []byte("\x01vorbis")):
+		return probeVorbis(stream)
+	case bytes.HasPrefix(first, []byte("OpusHead")):
+		return probeOpus(stream)
+	}
+
+	return Info{}, errors.New("unsupported codec in ogg stream")
+}
+
+func probeVorbis(stream oggStream) (Info, error) {
+	id := stream.Packets[0]
+	// type, "vorbis", version, channels, rate, 3 bitrates, blocksizes, framing
+	if len(id) < 30 {
+		return Info{}, errors.New("vorbis identification header is too short")
+	}
+	if version := binary.LittleEndian.Uint32(id[7:11]); version != 0 {
+		return Info{}, fmt.Errorf("unsupported vorbis version %d", version)
+	}
+
+	info := Info{
+		Codec:      "Vorbis",
+		Channels:   int(id[11]),
+		SampleRate: int(binary.LittleEndian.Uint32(id[12:16])),
+	}
+	if info.Channels == 0 || info.SampleRate == 0 {
+		return Info{}, errors.New("vorbis identification header has no channels or sample rate")
+	}
+	if id[29]&1 == 0 {
+		return Info{}, errors.New("vorbis identification header is missing its framing bit")
+	}
+
+	if len(stream.Packets) > 1 && bytes.HasPrefix(stream.Packets[1], []byte("\x03vorbis")) {
+		info.Vendor = readVendor(stream.Packets[1][7:])
+	}
+
+	info.Duration = samplesToDuration(stream.Granule, info.SampleRate)
+	return info, nil
+}
+
+func probeOpus(stream oggStream) (Info, error) {
+	head := stream.Packets[0]
+	// "OpusHead", version, channels, pre-skip, input rate, gain, mapping family
+	if len(head) < 19 {
+		return Info{}, errors.New("opus header is too short")
+	}
+
+	preSkip := int64(binary.LittleEndian.Uint16(head[10:12]))
+	info := Info{
+		Codec:    "Opus",
+		Channels: int(head[9]),
+		// Opus always decodes at 48 kHz; the header's rate is only the original input rate
+		SampleRate: 48000,
+	}
+	if info.Channels == 0 {
+		return Info{}, errors.New("opus header has no channels")
+	}
+
+	if len(stream.Packets) > 1 && bytes.HasPrefix(stream.Packets[1], []byte("OpusTags")) {
+		info.Vendor = readVendor(stream.Packets[1][8:])
+	}
+
+	info.Duration = samplesToDuration(stream.Granule-preSkip, info.SampleRate)
+	return info, nil
+}
+
+// readVendor reads the length-prefixed vendor string at the start of a comment header.
+func readVendor(b []byte) string {
+	if len(b) < 4 {
+		return ""
+	}
+	n := binary.LittleEndian.Uint32(b[:4])
+	if uint64(n) > uint64(len(b)-4) {
+		return ""
+	}
+	return string(b[4 : 4+n])
+}
+
+func samplesToDuration(samples int64, rate int) time.Duration {
+	if samples <= 0 || rate <= 0 {
+		return 0
+	}
+	return time.Duration(samples) * time.Second / time.Duration(rate)
+}
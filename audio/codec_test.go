@@ -0,0 +1,154 @@
+package audio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProbe_Vorbis(t *testing.T) {
+	info, err := Probe(bytes.NewReader(loadFixture(t)))
+	if err != nil {
+		t.Fatalf("Probe() error = %v", err)
+	}
+
+	want := Info{
+		Codec:      "Vorbis",
+		Channels:   1,
+		SampleRate: 48000,
+		Duration:   750 * time.Millisecond,
+		Vendor:     "Xiph.Org libVorbis I 20200704 (Reducing Environment)",
+	}
+	if info != want {
+		t.Errorf("Probe() = %+v, want %+v", info, want)
+	}
+	if got := info.String(); got != "Vorbis, 48000 Hz, mono, 0.75s" {
+		t.Errorf("Info.String() = %q", got)
+	}
+}
+
+func TestProbe_Opus(t *testing.T) {
+	head := []byte("OpusHead")
+	head = append(head, 1, 2)                            // version, channels
+	head = binary.LittleEndian.AppendUint16(head, 312)   // pre-skip
+	head = binary.LittleEndian.AppendUint32(head, 44100) // input sample rate
+	head = append(head, 0, 0, 0)                         // gain, mapping family
+	tags := binary.LittleEndian.AppendUint32([]byte("OpusTags"), 7)
+	tags = append(tags, "libopus"...)
+
+	var b bytes.Buffer
+	b.Write(buildPage(flagFirst, 0, 9, 0, head))
+	b.Write(buildPage(0, 0, 9, 1, tags))
+	b.Write(buildPage(flagLast, 48312, 9, 2, []byte{0xfc, 0xff}))
+
+	info, err := Probe(&b)
+	if err != nil {
+		t.Fatalf("Probe() error = %v", err)
+	}
+
+	want := Info{Codec: "Opus", Channels: 2, SampleRate: 48000, Duration: time.Second, Vendor: "libopus"}
+	if info != want {
+		t.Errorf("Probe() = %+v, want %+v", info, want)
+	}
+	if !strings.Contains(info.String(), "stereo") {
+		t.Errorf("Info.String() = %q, want stereo", info.String())
+	}
+}
+
+func TestProbe_Errors(t *testing.T) {
+	unknown := buildPage(flagFirst|flagLast, 0, 1, 0, []byte("\x80theora"))
+	if _, err := Probe(bytes.NewReader(unknown)); err == nil || !strings.Contains(err.Error(), "unsupported codec") {
+		t.Errorf("expected an unsupported codec error, got %v", err)
+	}
+
+	short := buildPage(flagFirst|flagLast, 0, 1, 0, []byte("\x01vorbis"))
+	if _, err := Probe(bytes.NewReader(short)); err == nil {
+		t.Error("expected an error for a short vorbis header")
+	}
+
+	if _, err := Probe(bytes.NewReader(nil)); err == nil {
+		t.Error("expected an error for empty input")
+	}
+}
+
+// loadReference reads testdata/vorbis.pcm, sample indexes and values from a
+// reference decode of testdata/vorbis.ogg, one second of mono audio at 44.1 kHz.
+// Both come from the test data of github.com/jfreymuth/oggvorbis (MIT).
+func loadReference(t *testing.T) map[int]float64 {
+	t.Helper()
+	f, err := os.Open("../testdata/vorbis.pcm")
+	if err != nil {
+		t.Fatalf("failed to read reference: %v", err)
+	}
+	defer f.Close()
+
+	reference := map[int]float64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		index, value, _ := strings.Cut(line, " ")
+		i, err1 := strconv.Atoi(index)
+		v, err2 := strconv.ParseFloat(value, 64)
+		if err1 != nil || err2 != nil {
+			t.Fatalf("bad reference line %q", line)
+		}
+		reference[i] = v
+	}
+	return reference
+}
+
+func TestDecode_Vorbis(t *testing.T) {
+	data, err := os.ReadFile("../testdata/vorbis.ogg")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	pcm, err := Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if pcm.Channels != 1 || pcm.SampleRate != 44100 || len(pcm.Samples) != 44100 {
+		t.Fatalf("Decode() = %d channels, %d Hz, %d samples", pcm.Channels, pcm.SampleRate, len(pcm.Samples))
+	}
+	if pcm.Duration() != time.Second {
+		t.Errorf("Duration() = %v, want 1s", pcm.Duration())
+	}
+
+	reference := loadReference(t)
+	if len(reference) == 0 {
+		t.Fatal("reference is empty")
+	}
+	for i, want := range reference {
+		if got := float64(pcm.Samples[i]); math.Abs(got-want) > 0.00002 {
+			t.Errorf("sample %d = %.7f, want %.7f", i, got, want)
+		}
+	}
+
+	info, err := Probe(bytes.NewReader(data))
+	if err != nil || info.Duration != pcm.Duration() {
+		t.Errorf("Probe() = %+v, %v, want the decoded duration", info, err)
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	head := []byte("OpusHead")
+	head = append(head, 1, 1, 0, 0, 0x80, 0xbb, 0, 0, 0, 0, 0)
+	opus := buildPage(flagFirst|flagLast, 0, 1, 0, head)
+	if _, err := Decode(bytes.NewReader(opus)); err == nil || !strings.Contains(err.Error(), "decoding Opus isn't supported") {
+		t.Errorf("expected Opus to be rejected, got %v", err)
+	}
+
+	// The fixture's audio packets are filler bytes
+	if _, err := Decode(bytes.NewReader(loadFixture(t))); err == nil {
+		t.Error("expected an error for audio that isn't valid Vorbis")
+	}
+}
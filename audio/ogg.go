@@ -0,0 +1,172 @@
+// Package audio reads and plays Pokémon cries. Cries are Ogg files; the Ogg
+// container and the Vorbis or Opus headers are parsed, and Vorbis audio
+// decoded, in pure Go so a cry can be checked and described without any
+// system libraries.
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrNotOgg      = errors.New("not an Ogg stream")
+	ErrBadChecksum = errors.New("ogg page checksum mismatch")
+)
+
+const (
+	pageHeaderSize = 27
+
+	flagContinued = 0x01
+	flagFirst     = 0x02
+	flagLast      = 0x04
+)
+
+// oggPage is a single page of an Ogg stream.
+type oggPage struct {
+	Flags    byte
+	Granule  int64
+	Serial   uint32
+	Sequence uint32
+	Segments []byte
+	Data     []byte
+}
+
+var crcTable = func() [256]uint32 {
+	var table [256]uint32
+	for i := range table {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04c11db7
+			} else {
+				r <<= 1
+			}
+		}
+		table[i] = r
+	}
+	return table
+}()
+
+// oggChecksum is the CRC-32 used by Ogg: polynomial 0x04c11db7, no reflection, zero initial value.
+func oggChecksum(b []byte) uint32 {
+	var crc uint32
+	for _, x := range b {
+		crc = crc<<8 ^ crcTable[byte(crc>>24)^x]
+	}
+	return crc
+}
+
+// readPage reads the next page and verifies its checksum. It returns io.EOF at the end of the stream.
+func readPage(r io.Reader) (oggPage, error) {
+	var page oggPage
+
+	header := make([]byte, pageHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return page, fmt.Errorf("truncated ogg page: %w", err)
+		}
+		return page, err
+	}
+	if !bytes.Equal(header[:4], []byte("OggS")) || header[4] != 0 {
+		return page, ErrNotOgg
+	}
+
+	page.Flags = header[5]
+	page.Granule = int64(binary.LittleEndian.Uint64(header[6:14])) // #nosec G115
+	page.Serial = binary.LittleEndian.Uint32(header[14:18])
+	page.Sequence = binary.LittleEndian.Uint32(header[18:22])
+	checksum := binary.LittleEndian.Uint32(header[22:26])
+
+	page.Segments = make([]byte, header[26])
+	if _, err := io.ReadFull(r, page.Segments); err != nil {
+		return page, fmt.Errorf("truncated ogg page: %w", err)
+	}
+
+	size := 0
+	for _, s := range page.Segments {
+		size += int(s)
+	}
+	page.Data = make([]byte, size)
+	if _, err := io.ReadFull(r, page.Data); err != nil {
+		return page, fmt.Errorf("truncated ogg page: %w", err)
+	}
+
+	// The checksum covers the whole page with the checksum field zeroed
+	binary.LittleEndian.PutUint32(header[22:26], 0)
+	whole := make([]byte, 0, len(header)+len(page.Segments)+len(page.Data))
+	whole = append(append(append(whole, header...), page.Segments...), page.Data...)
+	if oggChecksum(whole) != checksum {
+		return page, ErrBadChecksum
+	}
+
+	return page, nil
+}
+
+// oggStream reassembles the packets of the first logical stream in an Ogg file.
+type oggStream struct {
+	Packets [][]byte
+	// Granule is the granule position of the last page, i.e. the total sample count.
+	Granule int64
+}
+
+func readStream(r io.Reader) (oggStream, error) {
+	var (
+		stream  oggStream
+		serial  uint32
+		started bool
+		partial []byte
+	)
+
+	for {
+		page, err := readPage(r)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return stream, err
+		}
+
+		if !started {
+			if page.Flags&flagFirst == 0 {
+				return stream, errors.New("ogg stream does not start with a beginning-of-stream page")
+			}
+			serial, started = page.Serial, true
+		}
+		// Other multiplexed streams (none are expected in a cry) are ignored
+		if page.Serial != serial {
+			continue
+		}
+
+		if page.Flags&flagContinued == 0 {
+			partial = nil
+		}
+
+		offset := 0
+		for _, s := range page.Segments {
+			partial = append(partial, page.Data[offset:offset+int(s)]...)
+			offset += int(s)
+			// A segment shorter than 255 bytes ends the packet
+			if s < 255 {
+				stream.Packets = append(stream.Packets, partial)
+				partial = nil
+			}
+		}
+
+		if page.Granule >= 0 {
+			stream.Granule = page.Granule
+		}
+		if page.Flags&flagLast != 0 {
+			break
+		}
+	}
+
+	if !started {
+		return stream, ErrNotOgg
+	}
+
+	return stream, nil
+}
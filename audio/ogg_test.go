@@ -0,0 +1,131 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+// loadFixture reads testdata/cry.ogg, a small Ogg Vorbis stream with real
+// headers and page checksums whose audio packets are filler bytes. Its last
+// audio packet is split across two pages.
+func loadFixture(t *testing.T) []byte {
+	t.Helper()
+	data, err := os.ReadFile("../testdata/cry.ogg")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	return data
+}
+
+// buildPage encodes one Ogg page holding whole packets.
+func buildPage(flags byte, granule int64, serial, sequence uint32, packets ...[]byte) []byte {
+	var segments, data []byte
+	for _, p := range packets {
+		n := len(p)
+		for n >= 255 {
+			segments = append(segments, 255)
+			n -= 255
+		}
+		segments = append(segments, byte(n))
+		data = append(data, p...)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("OggS")
+	b.WriteByte(0)
+	b.WriteByte(flags)
+	_ = binary.Write(&b, binary.LittleEndian, granule)
+	_ = binary.Write(&b, binary.LittleEndian, serial)
+	_ = binary.Write(&b, binary.LittleEndian, sequence)
+	_ = binary.Write(&b, binary.LittleEndian, uint32(0))
+	b.WriteByte(byte(len(segments)))
+	b.Write(segments)
+	b.Write(data)
+
+	page := b.Bytes()
+	binary.LittleEndian.PutUint32(page[22:26], oggChecksum(page))
+	return page
+}
+
+func TestReadPage(t *testing.T) {
+	page, err := readPage(bytes.NewReader(loadFixture(t)))
+	if err != nil {
+		t.Fatalf("readPage() error = %v", err)
+	}
+
+	if page.Flags&flagFirst == 0 {
+		t.Error("first page should have the beginning-of-stream flag")
+	}
+	if page.Serial != 0x5eed || page.Sequence != 0 {
+		t.Errorf("unexpected serial/sequence: %x/%d", page.Serial, page.Sequence)
+	}
+	if len(page.Data) != 30 {
+		t.Errorf("expected a 30 byte identification header, got %d bytes", len(page.Data))
+	}
+}
+
+func TestReadPage_Errors(t *testing.T) {
+	fixture := loadFixture(t)
+
+	corrupt := append([]byte{}, fixture...)
+	corrupt[40] ^= 0xff
+	if _, err := readPage(bytes.NewReader(corrupt)); !errors.Is(err, ErrBadChecksum) {
+		t.Errorf("expected ErrBadChecksum, got %v", err)
+	}
+
+	if _, err := readPage(bytes.NewReader([]byte("RIFF....WAVEfmt ............"))); !errors.Is(err, ErrNotOgg) {
+		t.Errorf("expected ErrNotOgg, got %v", err)
+	}
+
+	if _, err := readPage(bytes.NewReader(fixture[:20])); err == nil || errors.Is(err, io.EOF) {
+		t.Errorf("expected a truncated page error, got %v", err)
+	}
+
+	if _, err := readPage(bytes.NewReader(nil)); !errors.Is(err, io.EOF) {
+		t.Errorf("expected io.EOF on an empty reader, got %v", err)
+	}
+}
+
+func TestReadStream(t *testing.T) {
+	stream, err := readStream(bytes.NewReader(loadFixture(t)))
+	if err != nil {
+		t.Fatalf("readStream() error = %v", err)
+	}
+
+	// identification, comment, setup and two audio packets
+	if len(stream.Packets) != 5 {
+		t.Fatalf("expected 5 packets, got %d", len(stream.Packets))
+	}
+	if n := len(stream.Packets[4]); n != 700 {
+		t.Errorf("packet continued across pages should be reassembled to 700 bytes, got %d", n)
+	}
+	if stream.Granule != 36000 {
+		t.Errorf("expected final granule 36000, got %d", stream.Granule)
+	}
+}
+
+func TestReadStream_IgnoresOtherSerials(t *testing.T) {
+	var b bytes.Buffer
+	b.Write(buildPage(flagFirst, 0, 1, 0, []byte("first")))
+	b.Write(buildPage(flagFirst, 0, 2, 0, []byte("other stream")))
+	b.Write(buildPage(flagLast, 10, 1, 1, []byte("second")))
+
+	stream, err := readStream(&b)
+	if err != nil {
+		t.Fatalf("readStream() error = %v", err)
+	}
+	if len(stream.Packets) != 2 || string(stream.Packets[1]) != "second" {
+		t.Errorf("unexpected packets: %q", stream.Packets)
+	}
+}
+
+func TestReadStream_MissingBeginning(t *testing.T) {
+	page := buildPage(0, 0, 1, 0, []byte("middle"))
+	if _, err := readStream(bytes.NewReader(page)); err == nil {
+		t.Error("expected an error for a stream without a beginning-of-stream page")
+	}
+}
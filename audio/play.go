@@ -0,0 +1,145 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/digitalghost-dev/poke-cli/connections"
+)
+
+const maxCryBytes = 2 * 1024 * 1024 // 2 MiB
+
+// Player is a command line audio player that can play Ogg files.
+type Player struct {
+	Name string
+	Args []string
+}
+
+// players are tried in order. afplay is left out because it can't play Ogg.
+var players = []Player{
+	{Name: "ffplay", Args: []string{"-nodisp", "-autoexit", "-loglevel", "quiet"}},
+	{Name: "mpv", Args: []string{"--no-video", "--really-quiet"}},
+	{Name: "pw-play"},
+	{Name: "paplay"},
+	{Name: "ogg123", Args: []string{"-q"}},
+	{Name: "play", Args: []string{"-q"}},
+}
+
+var (
+	lookPath   = exec.LookPath
+	httpClient = connections.NewDefaultHTTPClient()
+)
+
+// FindPlayer returns the first installed player.
+func FindPlayer() (Player, bool) {
+	for _, p := range players {
+		if _, err := lookPath(p.Name); err == nil {
+			return p, true
+		}
+	}
+	return Player{}, false
+}
+
+// Play writes data to a temporary file and plays it, blocking until playback ends.
+func (p Player) Play(data []byte) error {
+	tmp, err := os.CreateTemp("", "poke-cli-cry-*.ogg")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	args := append(append([]string{}, p.Args...), tmp.Name())
+	if err := exec.Command(p.Name, args...).Run(); err != nil { // #nosec G204
+		return fmt.Errorf("%s failed to play the cry: %w", p.Name, err)
+	}
+
+	return nil
+}
+
+// Download fetches a cry from the given URL.
+func Download(cryURL string) ([]byte, error) {
+	parsedURL, err := url.Parse(cryURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return nil, errors.New("cry is not available from the API")
+	}
+
+	resp, err := httpClient.Get(cryURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("non-200 response: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCryBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cry data: %w", err)
+	}
+	if len(body) > maxCryBytes {
+		return nil, fmt.Errorf("cry exceeds %d bytes", maxCryBytes)
+	}
+
+	return body, nil
+}
+
+// Save writes a cry to dir/name.ogg and returns the path written. The file is
+// written to a temporary name first so an interrupted save never leaves a
+// partial cry behind.
+func Save(dir string, name string, data []byte) (string, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	base := filepath.Base(name)
+	tmp, err := os.CreateTemp(dir, "."+base+"-*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	// CreateTemp makes the file private; saved cries are meant to be shared
+	if err := tmp.Chmod(0o644); err != nil { // #nosec G302
+		tmp.Close()
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write cry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write cry: %w", err)
+	}
+
+	path := filepath.Join(dir, base+".ogg")
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to write cry: %w", err)
+	}
+
+	return path, nil
+}
+
+// PlayerNames lists the players FindPlayer looks for.
+func PlayerNames() []string {
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.Name
+	}
+	return names
+}
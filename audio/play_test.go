@@ -0,0 +1,89 @@
+package audio
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFindPlayer(t *testing.T) {
+	original := lookPath
+	defer func() { lookPath = original }()
+
+	installed := map[string]bool{"paplay": true, "play": true}
+	lookPath = func(name string) (string, error) {
+		if installed[name] {
+			return "/usr/bin/" + name, nil
+		}
+		return "", errors.New("not found")
+	}
+
+	p, ok := FindPlayer()
+	if !ok || p.Name != "paplay" {
+		t.Errorf("FindPlayer() = %v, %v; want paplay", p, ok)
+	}
+
+	installed = map[string]bool{}
+	if _, ok := FindPlayer(); ok {
+		t.Error("FindPlayer() should report no player when none is installed")
+	}
+}
+
+func TestDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/25.ogg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("OggS"))
+	}))
+	defer server.Close()
+
+	data, err := Download(server.URL + "/25.ogg")
+	if err != nil || string(data) != "OggS" {
+		t.Errorf("Download() = %q, %v", data, err)
+	}
+
+	if _, err := Download(server.URL + "/missing.ogg"); err == nil || !strings.Contains(err.Error(), "non-200") {
+		t.Errorf("expected a non-200 error, got %v", err)
+	}
+
+	if _, err := Download(""); err == nil || !strings.Contains(err.Error(), "not available") {
+		t.Errorf("expected a not available error, got %v", err)
+	}
+}
+
+func TestSave(t *testing.T) {
+	dir := t.TempDir()
+
+	path, err := Save(dir, "pikachu-cry-latest", []byte("OggS"))
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if want := filepath.Join(dir, "pikachu-cry-latest.ogg"); path != want {
+		t.Errorf("Save() path = %q, want %q", path, want)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "OggS" {
+		t.Errorf("saved data = %q", data)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 {
+		t.Errorf("expected only the saved cry in %s, got %v (%v)", dir, entries, err)
+	}
+}
+
+func TestDownload_TooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(make([]byte, maxCryBytes+1))
+	}))
+	defer server.Close()
+
+	if _, err := Download(server.URL + "/25.ogg"); err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Errorf("expected an error for a cry over the limit, got %v", err)
+	}
+}
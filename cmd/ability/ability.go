@@ -7,6 +7,7 @@ import (
 
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
 	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/constants"
 	"github.com/digitalghost-dev/poke-cli/flags"
 	"github.com/digitalghost-dev/poke-cli/styling"
 	flag "github.com/spf13/pflag"
@@ -25,6 +26,8 @@ func AbilityCommand(args []string) (string, error) {
 					ShowHyphenHint: true,
 					Flags: []utils.FlagHelp{
 						{Short: "-p", Long: "--pokemon", Description: "Prints Pokémon that learn this ability."},
+						{Long: "--hidden-only", Description: "With --pokemon, only Pokémon that have it as a hidden ability."},
+						{Short: "-g", Long: "--gen", Description: "Prints the effect as it was in a generation, e.g. --gen 4."},
 					},
 				},
 			),
@@ -39,7 +42,7 @@ func AbilityCommand(args []string) (string, error) {
 
 	if err := utils.ValidateArgs(
 		args,
-		utils.Validator{MaxArgs: 3, CmdName: "ability", RequireName: true, HasFlags: true},
+		utils.Validator{MaxArgs: 6, CmdName: "ability", RequireName: true, HasFlags: true},
 	); err != nil {
 		output.WriteString(err.Error())
 		return output.String(), err
@@ -56,12 +59,33 @@ func AbilityCommand(args []string) (string, error) {
 		return output.String(), err
 	}
 
+	fail := func(msg string) (string, error) {
+		err := fmt.Errorf("%s", utils.FormatError(msg))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	gen := *af.Gen
+	switch {
+	case af.FlagSet.Changed("gen") && (gen < 1 || gen > len(constants.Generations)):
+		return fail(fmt.Sprintf("--gen must be between 1 and %d", len(constants.Generations)))
+	case *af.HiddenOnly && !*af.Pokemon:
+		return fail("--hidden-only only works with --pokemon")
+	case *af.HiddenOnly && gen > 0 && gen < 5:
+		return fail("Hidden abilities were introduced in Gen 5")
+	}
+
 	abilitiesStruct, abilityName, err := connections.AbilityApiCall(endpoint, abilityName, connections.APIURL)
 	if err != nil {
 		output.WriteString(err.Error())
 		return output.String(), err
 	}
 
+	capitalizedAbility := styling.CapitalizeResourceName(abilityName)
+	if introduced := introducedGen(abilitiesStruct); gen > 0 && gen < introduced {
+		return fail(fmt.Sprintf("%s was introduced in Gen %d", capitalizedAbility, introduced))
+	}
+
 	// Extract English short_effect
 	var englishShortEffect string
 	for _, entry := range abilitiesStruct.EffectEntries {
@@ -80,7 +104,6 @@ func AbilityCommand(args []string) (string, error) {
 		}
 	}
 
-	capitalizedAbility := styling.CapitalizeResourceName(abilityName)
 	output.WriteString(styling.StyleBold.Render(capitalizedAbility))
 	output.WriteByte('\n')
 
@@ -94,14 +117,22 @@ func AbilityCommand(args []string) (string, error) {
 
 	// API is missing some data for the short_effect for abilities from Generation 9.
 	// If short_effect is empty, fallback to the move's flavor_text_entry.
-	if englishShortEffect == "" {
-		fmt.Fprintf(&output, "%s Effect: %s", styling.ColoredBullet, englishFlavorEntry)
+	effect := englishShortEffect
+	if effect == "" {
+		effect = englishFlavorEntry
+	}
+
+	if gen > 0 {
+		fmt.Fprintf(&output, "%s Effect in Gen %d: %s", styling.ColoredBullet, gen, effectInGen(abilitiesStruct, gen, effect))
 	} else {
-		fmt.Fprintf(&output, "%s Effect: %s", styling.ColoredBullet, englishShortEffect)
+		fmt.Fprintf(&output, "%s Effect: %s", styling.ColoredBullet, effect)
+		for _, c := range effectChanges(abilitiesStruct) {
+			fmt.Fprintf(&output, "\n%s Before Gen %d (%s): %s", styling.ColoredBullet, c.gen, styling.CapitalizeResourceName(c.versionGroup), c.effect)
+		}
 	}
 
 	if *af.Pokemon {
-		if err := flags.PokemonAbilitiesFlag(&output, endpoint, abilityName); err != nil {
+		if err := flags.PokemonAbilitiesFlag(&output, endpoint, abilityName, *af.HiddenOnly, gen); err != nil {
 			return utils.HandleFlagError(&output, err)
 		}
 	}
@@ -74,3 +74,22 @@ func TestAbilityCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestAbilityCommand_FlagErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+	}{
+		{"gen out of range", []string{"ability", "levitate", "--gen", "10"}, "--gen must be between 1 and 9"},
+		{"hidden-only without pokemon", []string{"ability", "levitate", "--hidden-only"}, "--hidden-only only works with --pokemon"},
+		{"hidden abilities before gen 5", []string{"ability", "levitate", "-p", "--hidden-only", "-g", "4"}, "Hidden abilities were introduced in Gen 5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := AbilityCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.contains)
+		})
+	}
+}
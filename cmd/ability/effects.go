@@ -0,0 +1,55 @@
+package ability
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/digitalghost-dev/poke-cli/constants"
+	"github.com/digitalghost-dev/poke-cli/structs"
+)
+
+// effectChange is an effect an ability had before it changed in versionGroup.
+type effectChange struct {
+	gen          int
+	versionGroup string
+	effect       string
+}
+
+// introducedGen is the generation an ability first appeared in, or 0 when
+// the API doesn't say.
+func introducedGen(a structs.AbilityJSONStruct) int {
+	return slices.Index(constants.Generations, a.Generation.Name) + 1
+}
+
+// effectChanges lists an ability's previous English effects, oldest first.
+func effectChanges(a structs.AbilityJSONStruct) []effectChange {
+	var changes []effectChange
+	for _, c := range a.EffectChanges {
+		for _, entry := range c.EffectEntries {
+			if entry.Language.Name != "en" {
+				continue
+			}
+			changes = append(changes, effectChange{
+				gen:          constants.VersionGroupGenerations[c.VersionGroup.Name],
+				versionGroup: c.VersionGroup.Name,
+				effect:       strings.Join(strings.Fields(entry.Effect), " "),
+			})
+			break
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].gen < changes[j].gen })
+	return changes
+}
+
+// effectInGen is the effect an ability had in a generation: that of the first
+// change made after it, or current when it hasn't changed since.
+func effectInGen(a structs.AbilityJSONStruct, gen int, current string) string {
+	for _, c := range effectChanges(a) {
+		if gen < c.gen {
+			return c.effect
+		}
+	}
+	return current
+}
@@ -0,0 +1,49 @@
+package ability
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stench has Stench's effect change: it had no effect in battle until it
+// changed in Black and White.
+func stench(t *testing.T) structs.AbilityJSONStruct {
+	t.Helper()
+	var a structs.AbilityJSONStruct
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "stench",
+		"generation": {"name": "generation-iii"},
+		"effect_changes": [
+			{"version_group": {"name": "black-white"}, "effect_entries": [
+				{"effect": "N'a aucun effet en combat.", "language": {"name": "fr"}},
+				{"effect": "Has no effect\nin battle.", "language": {"name": "en"}}
+			]}
+		]
+	}`), &a))
+	return a
+}
+
+func TestIntroducedGen(t *testing.T) {
+	assert.Equal(t, 3, introducedGen(stench(t)))
+	assert.Equal(t, 0, introducedGen(structs.AbilityJSONStruct{}))
+}
+
+func TestEffectChanges(t *testing.T) {
+	changes := effectChanges(stench(t))
+	require.Len(t, changes, 1)
+	assert.Equal(t, effectChange{gen: 5, versionGroup: "black-white", effect: "Has no effect in battle."}, changes[0])
+}
+
+func TestEffectInGen(t *testing.T) {
+	a := stench(t)
+	const current = "Has a 10% chance of making target Pokémon flinch with each hit."
+
+	assert.Equal(t, "Has no effect in battle.", effectInGen(a, 3, current))
+	assert.Equal(t, "Has no effect in battle.", effectInGen(a, 4, current))
+	assert.Equal(t, current, effectInGen(a, 5, current), "the effect changed in Gen 5")
+	assert.Equal(t, current, effectInGen(a, 9, current))
+}
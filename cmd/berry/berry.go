@@ -19,8 +19,18 @@ func BerryCommand(args []string) (string, error) {
 		output.WriteString(
 			utils.GenerateHelpMessage(
 				utils.HelpConfig{
-					Description: "Get details about a specific berry.",
+					Description: "Get details about a specific berry, or list berries by flavor and growth.",
 					CmdName:     "berry",
+					SubCmdName:  "[<name> | plan]",
+					Flags: []utils.FlagHelp{
+						{Short: "-f", Long: "--flavor", Description: "Only berries with this flavor: spicy, dry, sweet, bitter or sour."},
+						{Short: "-p", Long: "--min-potency", Description: "Lowest potency of --flavor, or of the strongest flavor."},
+						{Long: "--firmness", Description: "Only berries with this firmness, e.g. soft or very-hard."},
+						{Short: "-g", Long: "--gift-type", Description: "Only berries whose Natural Gift has this type."},
+						{Short: "-s", Long: "--sort", Description: "Sort by name, growth_time, max_harvest, natural_gift_power, smoothness, size or potency."},
+						{Short: "-t", Long: "--target", Description: "With plan, Poffin levels to aim for, e.g. spicy=30,dry=10."},
+						{Long: "--harvest", Description: "With plan, plants needed for at least this many berries."},
+					},
 				},
 			),
 		)
@@ -30,6 +40,15 @@ func BerryCommand(args []string) (string, error) {
 		return output.String(), nil
 	}
 
+	if len(args) > 1 {
+		switch {
+		case args[1] == "plan":
+			return planCommand(args[2:])
+		case strings.HasPrefix(args[1], "-"):
+			return berryFilter(args[1:])
+		}
+	}
+
 	// Validate arguments
 	if err := utils.ValidateArgs(
 		args,
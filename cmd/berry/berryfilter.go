@@ -0,0 +1,308 @@
+package berry
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// flavorBarWidth is the widest flavor bar, at maxPotency.
+	flavorBarWidth = 4
+	maxPotency     = 40
+)
+
+var (
+	berryFlavors  = []string{"spicy", "dry", "sweet", "bitter", "sour"}
+	berryFirmness = []string{"very-soft", "soft", "hard", "very-hard", "super-hard"}
+
+	// flavorColors follow the Pokéblock and Poffin colors for each flavor.
+	flavorColors = map[string]string{
+		"spicy":  "#F05030",
+		"dry":    "#3890F0",
+		"sweet":  "#F080B8",
+		"bitter": "#58C050",
+		"sour":   "#F8D030",
+	}
+
+	// berrySorts maps --sort values to ORDER BY clauses. Growth time and
+	// smoothness sort lowest first, the other numbers highest first.
+	berrySorts = map[string]string{
+		"name":               "b.name",
+		"growth_time":        "b.growth_time, b.name",
+		"max_harvest":        "b.max_harvest DESC, b.name",
+		"natural_gift_power": "b.natural_gift_power DESC, b.name",
+		"smoothness":         "b.smoothness, b.name",
+		"size":               "b.size DESC, b.name",
+		// potency sorts by --flavor, or by the strongest flavor without it
+		"potency": "",
+	}
+)
+
+// berryFilter lists the berries matching the flags, e.g.
+// poke-cli berry --flavor spicy --min-potency 20 --sort growth_time.
+func berryFilter(args []string) (string, error) {
+	var output strings.Builder
+
+	bf := flags.SetupBerryFlagSet()
+	if err := bf.FlagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("berry", err))
+		return output.String(), err
+	}
+	if bf.FlagSet.NArg() > 0 {
+		err := fmt.Errorf("berry flags can't be combined with a berry name")
+		output.WriteString(utils.FormatError(err.Error()))
+		return output.String(), err
+	}
+
+	if err := validateFilter(bf); err != nil {
+		output.WriteString(utils.FormatError(err.Error()))
+		return output.String(), err
+	}
+
+	rows, err := filterBerries(bf)
+	if err != nil {
+		output.WriteString(utils.FormatError(err.Error()))
+		return output.String(), err
+	}
+
+	output.WriteString(renderBerries(rows, bf))
+	return output.String(), nil
+}
+
+type berryRow struct {
+	structs.Berry
+	flavors []int
+}
+
+// filterQuery builds the berry query for the given flags. Flag values are
+// checked against fixed lists before they reach the SQL; only the potency and
+// gift type are passed as arguments.
+func filterQuery(bf *flags.BerryFlags) (string, []any) {
+	potency := "MAX(f.spicy, f.dry, f.sweet, f.bitter, f.sour)"
+	if *bf.Flavor != "" {
+		potency = "f." + *bf.Flavor
+	}
+
+	var where []string
+	var args []any
+	if *bf.Flavor != "" || *bf.MinPotency > 0 {
+		where = append(where, potency+" >= ?")
+		args = append(args, max(*bf.MinPotency, 1))
+	}
+	if *bf.Firmness != "" {
+		where = append(where, "b.firmness = ?")
+		args = append(args, *bf.Firmness)
+	}
+	if *bf.GiftType != "" {
+		where = append(where, "b.natural_gift_type = ?")
+		args = append(args, *bf.GiftType)
+	}
+	clause := ""
+	if len(where) > 0 {
+		clause = "WHERE " + strings.Join(where, " AND ")
+	}
+	order := berrySorts[*bf.Sort]
+	if *bf.Sort == "potency" {
+		order = potency + " DESC, b.name"
+	}
+
+	query := fmt.Sprintf(`
+		WITH f AS (
+			SELECT
+				berry_id,
+				MAX(CASE flavor_name WHEN 'spicy' THEN potency ELSE 0 END) AS spicy,
+				MAX(CASE flavor_name WHEN 'dry' THEN potency ELSE 0 END) AS dry,
+				MAX(CASE flavor_name WHEN 'sweet' THEN potency ELSE 0 END) AS sweet,
+				MAX(CASE flavor_name WHEN 'bitter' THEN potency ELSE 0 END) AS bitter,
+				MAX(CASE flavor_name WHEN 'sour' THEN potency ELSE 0 END) AS sour
+			FROM
+				berry_flavors
+			GROUP BY
+				berry_id
+		)
+		SELECT
+			b.*
+		FROM
+			berries b
+		JOIN
+			f ON f.berry_id = b.id
+		%s
+		ORDER BY
+			%s`, clause, order)
+	return query, args
+}
+
+// validateFilter checks the flag values, so only known names reach the query.
+func validateFilter(bf *flags.BerryFlags) error {
+	*bf.Flavor = strings.ToLower(*bf.Flavor)
+	*bf.Firmness = strings.ToLower(*bf.Firmness)
+	*bf.GiftType = strings.ToLower(*bf.GiftType)
+	*bf.Sort = strings.ToLower(*bf.Sort)
+
+	switch {
+	case *bf.Flavor != "" && !slices.Contains(berryFlavors, *bf.Flavor):
+		return fmt.Errorf("invalid flavor %q\nChoose from %s", *bf.Flavor, strings.Join(berryFlavors, ", "))
+	case *bf.MinPotency < 0:
+		return fmt.Errorf("--min-potency must be at least 0")
+	case *bf.Firmness != "" && !slices.Contains(berryFirmness, *bf.Firmness):
+		return fmt.Errorf("invalid firmness %q\nChoose from %s", *bf.Firmness, strings.Join(berryFirmness, ", "))
+	}
+	if _, ok := berrySorts[*bf.Sort]; !ok {
+		sorts := make([]string, 0, len(berrySorts))
+		for s := range berrySorts {
+			sorts = append(sorts, s)
+		}
+		slices.Sort(sorts)
+		return fmt.Errorf("invalid sort %q\nChoose from %s", *bf.Sort, strings.Join(sorts, ", "))
+	}
+	if *bf.GiftType != "" {
+		types, err := connections.QueryBerryData(`SELECT DISTINCT natural_gift_type FROM berries ORDER BY natural_gift_type`)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(types, *bf.GiftType) {
+			return fmt.Errorf("no berry has a %q Natural Gift\nChoose from %s", *bf.GiftType, strings.Join(types, ", "))
+		}
+	}
+	return nil
+}
+
+func filterBerries(bf *flags.BerryFlags) ([]berryRow, error) {
+	query, args := filterQuery(bf)
+	berries, err := connections.QueryBerries(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return withFlavors(berries)
+}
+
+// withFlavors pairs each berry with its potencies, in berryFlavors order.
+func withFlavors(berries []structs.Berry) ([]berryRow, error) {
+	flavors, err := connections.QueryBerryFlavors(`SELECT * FROM berry_flavors`)
+	if err != nil {
+		return nil, err
+	}
+
+	potencies := map[int]map[string]int{}
+	for _, f := range flavors {
+		if potencies[f.BerryID] == nil {
+			potencies[f.BerryID] = map[string]int{}
+		}
+		potencies[f.BerryID][f.FlavorName] = f.Potency
+	}
+
+	rows := make([]berryRow, len(berries))
+	for i, b := range berries {
+		rows[i] = berryRow{Berry: b}
+		for _, f := range berryFlavors {
+			rows[i].flavors = append(rows[i].flavors, potencies[b.ID][f])
+		}
+	}
+	return rows, nil
+}
+
+// flavorBar draws a potency as a bar in the flavor's color, followed by the number.
+func flavorBar(flavor string, potency int) string {
+	return scaledFlavorBar(flavor, potency, maxPotency)
+}
+
+// scaledFlavorBar draws a full bar at limit.
+func scaledFlavorBar(flavor string, potency, limit int) string {
+	if potency <= 0 {
+		return lipgloss.NewStyle().Foreground(styling.Gray).Render(fmt.Sprintf("%-*s %2s", flavorBarWidth, "·", "0"))
+	}
+	filled := min(max(potency*flavorBarWidth/limit, 1), flavorBarWidth)
+	bar := lipgloss.NewStyle().Foreground(lipgloss.Color(flavorColors[flavor])).Render(strings.Repeat("█", filled))
+	return fmt.Sprintf("%s%s %2d", bar, strings.Repeat(" ", flavorBarWidth-filled), potency)
+}
+
+// describeFilter is the heading above the results, e.g. "spicy ≥ 20, hard".
+func describeFilter(bf *flags.BerryFlags) string {
+	var parts []string
+	switch {
+	case *bf.Flavor != "":
+		parts = append(parts, fmt.Sprintf("%s ≥ %d", *bf.Flavor, max(*bf.MinPotency, 1)))
+	case *bf.MinPotency > 0:
+		parts = append(parts, fmt.Sprintf("a flavor ≥ %d", *bf.MinPotency))
+	}
+	if *bf.Firmness != "" {
+		parts = append(parts, *bf.Firmness)
+	}
+	if *bf.GiftType != "" {
+		parts = append(parts, styling.CapitalizeResourceName(*bf.GiftType)+" Natural Gift")
+	}
+	return strings.Join(parts, ", ")
+}
+
+func renderBerries(rows []berryRow, bf *flags.BerryFlags) string {
+	var output strings.Builder
+
+	heading := fmt.Sprintf("%d berries", len(rows))
+	if len(rows) == 1 {
+		heading = "1 berry"
+	}
+	if filter := describeFilter(bf); filter != "" {
+		heading += " with " + filter
+	}
+	output.WriteString(styling.StyleBold.Render(heading + ", sorted by " + *bf.Sort))
+	output.WriteString("\n")
+	if len(rows) == 0 {
+		return output.String()
+	}
+
+	headers := []string{"Berry", "Firmness", "Growth", "Harvest", "Natural Gift"}
+	for _, f := range berryFlavors {
+		headers = append(headers, styling.CapitalizeResourceName(f))
+	}
+	cells := make([][]string, len(rows))
+	for i, r := range rows {
+		gift := lipgloss.NewStyle().Foreground(lipgloss.Color(styling.GetTypeColor(r.NaturalGiftType))).
+			Render(fmt.Sprintf("%s %d", styling.CapitalizeResourceName(r.NaturalGiftType), r.NaturalGiftPower))
+		cells[i] = []string{
+			styling.CapitalizeResourceName(r.Name),
+			r.Firmness,
+			fmt.Sprintf("%dh", r.GrowthTime),
+			strconv.Itoa(r.MaxHarvest),
+			gift,
+		}
+		for j, f := range berryFlavors {
+			cells[i] = append(cells[i], flavorBar(f, r.flavors[j]))
+		}
+	}
+
+	output.WriteString(berryTable(headers, cells))
+	output.WriteString("\n")
+	return output.String()
+}
+
+func berryTable(headers []string, rows [][]string) string {
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(styling.Gray)).
+		BorderColumn(true).
+		Headers(headers...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == table.HeaderRow {
+				style = style.Bold(true)
+			}
+			return style
+		}).
+		Render()
+}
@@ -0,0 +1,83 @@
+package berry
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/flags"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBerryCommand_Filter(t *testing.T) {
+	output, err := BerryCommand([]string{"berry", "--flavor", "Spicy", "--min-potency", "20", "--firmness", "super-hard"})
+	require.NoError(t, err)
+
+	out := styling.StripANSI(output)
+	assert.Contains(t, out, "2 berries with spicy ≥ 20, super-hard, sorted by name")
+	assert.Contains(t, out, "Babiri")
+	assert.Contains(t, out, "Starf")
+	assert.NotContains(t, out, "Occa", "Occa is only 15 spicy")
+	assert.Contains(t, out, "██   25")
+}
+
+func TestBerryCommand_FilterSort(t *testing.T) {
+	output, err := BerryCommand([]string{"berry", "-g", "fire", "-s", "growth_time"})
+	require.NoError(t, err)
+
+	out := styling.StripANSI(output)
+	assert.Contains(t, out, "4 berries with Fire Natural Gift")
+	bluk, cheri, occa := strings.Index(out, "Bluk"), strings.Index(out, "Cheri"), strings.Index(out, "Occa")
+	assert.True(t, bluk < cheri && cheri < occa, "expected the fastest growing berries first")
+}
+
+func TestBerryCommand_FilterNoMatches(t *testing.T) {
+	output, err := BerryCommand([]string{"berry", "--flavor", "sour", "--min-potency", "40", "--firmness", "very-soft", "--gift-type", "fire"})
+	require.NoError(t, err)
+	assert.Contains(t, styling.StripANSI(output), "0 berries with sour ≥ 40")
+}
+
+func TestBerryCommand_FilterErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+	}{
+		{"unknown flavor", []string{"berry", "--flavor", "salty"}, "invalid flavor"},
+		{"negative potency", []string{"berry", "-p", "-5"}, "at least 0"},
+		{"unknown firmness", []string{"berry", "--firmness", "crunchy"}, "invalid firmness"},
+		{"unknown gift type", []string{"berry", "-g", "stellar"}, "Natural Gift"},
+		{"unknown sort", []string{"berry", "--sort", "color"}, "invalid sort"},
+		{"unknown flag", []string{"berry", "--color", "red"}, "unknown flag"},
+		{"name with flags", []string{"berry", "-f", "dry", "cheri"}, "can't be combined"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := BerryCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.contains)
+		})
+	}
+}
+
+func TestFlavorBar(t *testing.T) {
+	assert.Equal(t, "·     0", styling.StripANSI(flavorBar("dry", 0)))
+	assert.Equal(t, "█     5", styling.StripANSI(flavorBar("dry", 5)), "any potency gets a block")
+	assert.Equal(t, "████ 40", styling.StripANSI(flavorBar("dry", 40)))
+}
+
+func TestFilterQuery(t *testing.T) {
+	bf := flags.SetupBerryFlagSet()
+	*bf.Sort = "potency"
+	query, args := filterQuery(bf)
+	assert.Empty(t, args)
+	assert.NotContains(t, query, "WHERE")
+	assert.Contains(t, query, "MAX(f.spicy, f.dry, f.sweet, f.bitter, f.sour) DESC")
+
+	*bf.Flavor = "bitter"
+	query, args = filterQuery(bf)
+	assert.Equal(t, []any{1}, args, "a flavor alone means any potency of it")
+	assert.Contains(t, query, "f.bitter >= ?")
+}
@@ -1,21 +1,12 @@
 package berry
 
 import (
-	"image"
-	"io"
-	"net/http"
-	"strings"
-
-	"charm.land/lipgloss/v2"
 	"github.com/digitalghost-dev/poke-cli/connections"
-	"github.com/digitalghost-dev/poke-cli/styling"
-	"github.com/disintegration/imaging"
+	"github.com/digitalghost-dev/poke-cli/imaging"
 )
 
 const maxBerryImageBytes = 5 * 1024 * 1024 // 5 MiB
 
-var berryImageHTTPClient = connections.NewDefaultHTTPClient()
-
 func berryExists(name string) (bool, error) {
 	results, err := connections.QueryBerryData(`
 		SELECT 1 FROM berries
@@ -84,62 +75,15 @@ func berryImage(berryName string) string {
 		return "Image information not available"
 	}
 
-	ToString := func(width int, height int, img image.Image) string {
-		img = imaging.Resize(img, width, height, imaging.NearestNeighbor)
-		b := img.Bounds()
-
-		imageWidth := b.Max.X
-		h := b.Max.Y
-
-		rowCount := (h - 1) / 2
-		if h%2 != 0 {
-			rowCount++
-		}
-		estimatedSize := (imageWidth * rowCount * 10) + rowCount
-
-		str := strings.Builder{}
-		str.Grow(estimatedSize)
-
-		styleCache := make(map[string]lipgloss.Style)
-
-		for heightCounter := 0; heightCounter < h-1; heightCounter += 2 {
-			for x := 0; x < imageWidth; x++ {
-				// Get the color of the current and next row's pixels
-				c1, _ := styling.MakeColor(img.At(x, heightCounter))
-				color1 := lipgloss.Color(c1.Hex())
-				c2, _ := styling.MakeColor(img.At(x, heightCounter+1))
-				color2 := lipgloss.Color(c2.Hex())
-
-				styleKey := c1.Hex() + "_" + c2.Hex()
-				style, exists := styleCache[styleKey]
-				if !exists {
-					style = lipgloss.NewStyle().Foreground(color1).Background(color2)
-					styleCache[styleKey] = style
-				}
-
-				str.WriteString(style.Render("▀"))
-			}
-
-			str.WriteString("\n")
-		}
-
-		return str.String()
-	}
-
-	imageResp, err := berryImageHTTPClient.Get(berryImage[0])
+	img, err := imaging.Fetch(berryImage[0], maxBerryImageBytes)
 	if err != nil {
 		return "Error downloading berry image"
 	}
-	defer imageResp.Body.Close()
-
-	if imageResp.StatusCode != http.StatusOK {
-		return "Error downloading berry image"
-	}
 
-	img, err := imaging.Decode(io.LimitReader(imageResp.Body, maxBerryImageBytes))
+	imgStr, err := imaging.Render(img, imaging.BestText(), imaging.Options{Width: 28, Height: 28})
 	if err != nil {
 		return "Error decoding berry image"
 	}
 
-	return ToString(28, 28, img)
+	return imgStr
 }
@@ -0,0 +1,434 @@
+package berry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// maxPoffinBerries is one berry per player at the cooking table.
+	maxPoffinBerries = 4
+	maxPoffinLevel   = 100
+	// mildLevel is the level from which a Poffin is a Mild Poffin.
+	mildLevel = 50
+	// fullSoil is the moisture of freshly watered soil, which drops by the
+	// berry's soil_dryness every hour.
+	fullSoil = 100
+	// growthStages is how many stages a tree grows through before it bears
+	// berries. A berry's growth_time is the hours each one takes.
+	growthStages = 4
+)
+
+// poffin is the result of cooking a set of berries, before the cooking time
+// bonus and any spills or burns.
+type poffin struct {
+	berries    []berryRow
+	levels     []int
+	smoothness int
+}
+
+// cookPoffin applies the Gen 4 flavor rules, which Pokéblocks share: each
+// flavor is weakened by the next one (spicy by dry, ..., sour by spicy), then
+// every flavor loses one point per flavor that went negative.
+func cookPoffin(berries []berryRow) poffin {
+	n := len(berryFlavors)
+	sums := make([]int, n)
+	smoothness := 0
+	for _, b := range berries {
+		for i, p := range b.flavors {
+			sums[i] += p
+		}
+		smoothness += b.Smoothness
+	}
+
+	levels := make([]int, n)
+	negatives := 0
+	for i := range levels {
+		levels[i] = sums[i] - sums[(i+1)%n]
+		if levels[i] < 0 {
+			negatives++
+		}
+	}
+	for i := range levels {
+		levels[i] = min(max(levels[i]-negatives, 0), maxPoffinLevel)
+	}
+
+	return poffin{
+		berries:    berries,
+		levels:     levels,
+		smoothness: smoothness/len(berries) - len(berries),
+	}
+}
+
+// level is the Poffin's strongest flavor.
+func (p poffin) level() int {
+	return slices.Max(p.levels)
+}
+
+func (p poffin) name() string {
+	var flavors []string
+	for i, l := range p.levels {
+		if l > 0 {
+			flavors = append(flavors, styling.CapitalizeResourceName(berryFlavors[i]))
+		}
+	}
+	switch {
+	case len(flavors) == 0:
+		return "Foul Poffin"
+	case p.level() >= mildLevel:
+		return "Mild Poffin"
+	case len(flavors) >= 4:
+		return "Overripe Poffin"
+	case len(flavors) == 3:
+		return "Rich Poffin"
+	}
+	return strings.Join(flavors, "-") + " Poffin"
+}
+
+// readyIn is how long the slowest berry takes to grow, in hours.
+func (p poffin) readyIn() int {
+	hours := 0
+	for _, b := range p.berries {
+		hours = max(hours, harvestTime(b))
+	}
+	return hours
+}
+
+// harvestTime is how many hours a planted berry takes to bear berries.
+func harvestTime(b berryRow) int {
+	return b.GrowthTime * growthStages
+}
+
+// wateringInterval is how many hours a plant goes before its soil dries out.
+func wateringInterval(b berryRow) int {
+	if b.SoilDryness <= 0 {
+		return 0
+	}
+	return fullSoil / b.SoilDryness
+}
+
+// parseTarget reads levels such as "spicy=30,dry=10" into berryFlavors order.
+func parseTarget(target string) ([]int, error) {
+	levels := make([]int, len(berryFlavors))
+	for _, part := range strings.Split(target, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid target %q\nUse flavor=level pairs, e.g. spicy=30,dry=10", part)
+		}
+		i := slices.Index(berryFlavors, strings.ToLower(strings.TrimSpace(name)))
+		if i < 0 {
+			return nil, fmt.Errorf("invalid flavor %q\nChoose from %s", name, strings.Join(berryFlavors, ", "))
+		}
+		level, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || level < 0 || level > maxPoffinLevel {
+			return nil, fmt.Errorf("invalid level %q for %s\nLevels go from 0 to %d", value, berryFlavors[i], maxPoffinLevel)
+		}
+		levels[i] = level
+	}
+	return levels, nil
+}
+
+// distance is how far a Poffin's levels are from the target.
+func distance(levels, target []int) int {
+	d := 0
+	for i := range levels {
+		d += int(math.Abs(float64(levels[i] - target[i])))
+	}
+	return d
+}
+
+// combinations calls fn with every set of k different berries. Cooking the
+// same berry twice makes a Foul Poffin, so berries are never repeated.
+func combinations(berries []berryRow, k int, fn func([]berryRow)) {
+	picked := make([]berryRow, 0, k)
+	var walk func(start int)
+	walk = func(start int) {
+		if len(picked) == k {
+			fn(picked)
+			return
+		}
+		for i := start; i <= len(berries)-(k-len(picked)); i++ {
+			picked = append(picked, berries[i])
+			walk(i + 1)
+			picked = picked[:len(picked)-1]
+		}
+	}
+	walk(0)
+}
+
+// planPoffins returns the best Poffins from the berries. With a flavor, the
+// strongest of that flavor come first; with a target, the closest.
+func planPoffins(berries []berryRow, count int, flavor string, target []int, top int) []poffin {
+	fi := slices.Index(berryFlavors, flavor)
+	better := func(a, b poffin) bool {
+		if target != nil {
+			if da, db := distance(a.levels, target), distance(b.levels, target); da != db {
+				return da < db
+			}
+		} else {
+			if a.levels[fi] != b.levels[fi] {
+				return a.levels[fi] > b.levels[fi]
+			}
+			// Other flavors only get in the way
+			if oa, ob := sumLevels(a.levels)-a.levels[fi], sumLevels(b.levels)-b.levels[fi]; oa != ob {
+				return oa < ob
+			}
+		}
+		if a.smoothness != b.smoothness {
+			return a.smoothness < b.smoothness
+		}
+		return a.readyIn() < b.readyIn()
+	}
+
+	var best []poffin
+	combinations(berries, count, func(picked []berryRow) {
+		p := cookPoffin(picked)
+		if target == nil && p.levels[fi] == 0 {
+			return
+		}
+		if len(best) == top && !better(p, best[top-1]) {
+			return
+		}
+		p.berries = slices.Clone(picked)
+		i := sort.Search(len(best), func(i int) bool { return better(p, best[i]) })
+		best = slices.Insert(best, i, p)
+		if len(best) > top {
+			best = best[:top]
+		}
+	})
+	return best
+}
+
+func sumLevels(levels []int) int {
+	total := 0
+	for _, l := range levels {
+		total += l
+	}
+	return total
+}
+
+// harvestPlan is how many plants of a berry reach a yield.
+type harvestPlan struct {
+	berry  berryRow
+	plants int
+}
+
+func planHarvest(berries []berryRow, yield, top int) []harvestPlan {
+	plans := make([]harvestPlan, 0, len(berries))
+	for _, b := range berries {
+		if b.MaxHarvest <= 0 {
+			continue
+		}
+		plans = append(plans, harvestPlan{berry: b, plants: (yield + b.MaxHarvest - 1) / b.MaxHarvest})
+	}
+	sort.SliceStable(plans, func(i, j int) bool {
+		a, b := plans[i], plans[j]
+		if a.berry.GrowthTime != b.berry.GrowthTime {
+			return a.berry.GrowthTime < b.berry.GrowthTime
+		}
+		if a.plants != b.plants {
+			return a.plants < b.plants
+		}
+		return wateringInterval(a.berry) > wateringInterval(b.berry)
+	})
+	return plans[:min(len(plans), top)]
+}
+
+// planCommand handles 'poke-cli berry plan [flags]'
+func planCommand(args []string) (string, error) {
+	var output strings.Builder
+
+	fail := func(err error) (string, error) {
+		output.WriteString(utils.FormatError(err.Error()))
+		return output.String(), err
+	}
+
+	pf := flags.SetupBerryPlanFlagSet()
+	if err := pf.FlagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("berry", err))
+		return output.String(), err
+	}
+
+	modes := 0
+	for _, set := range []bool{*pf.Flavor != "", *pf.Target != "", *pf.Harvest > 0} {
+		if set {
+			modes++
+		}
+	}
+	switch {
+	case pf.FlagSet.NArg() > 0:
+		return fail(fmt.Errorf("berry plan doesn't take arguments, only flags"))
+	case *pf.Harvest < 0:
+		return fail(fmt.Errorf("--harvest must be at least 1"))
+	case modes != 1:
+		return fail(fmt.Errorf("berry plan needs one of --flavor, --target or --harvest"))
+	case *pf.Berry != "" && *pf.Harvest == 0:
+		return fail(fmt.Errorf("--berry only works with --harvest, use --from to pick Poffin berries"))
+	case *pf.Berries < 1 || *pf.Berries > maxPoffinBerries:
+		return fail(fmt.Errorf("--berries must be between 1 and %d", maxPoffinBerries))
+	case *pf.Top < 1:
+		return fail(fmt.Errorf("--top must be at least 1"))
+	}
+
+	berries, err := connections.QueryBerries(`SELECT * FROM berries ORDER BY name`)
+	if err != nil {
+		return fail(err)
+	}
+	rows, err := withFlavors(berries)
+	if err != nil {
+		return fail(err)
+	}
+	if rows, err = pickBerries(rows, *pf.From, *pf.Berry); err != nil {
+		return fail(err)
+	}
+
+	if *pf.Harvest > 0 {
+		output.WriteString(renderHarvest(planHarvest(rows, *pf.Harvest, *pf.Top), *pf.Harvest))
+		return output.String(), nil
+	}
+
+	var target []int
+	flavor := strings.ToLower(*pf.Flavor)
+	if *pf.Target != "" {
+		if target, err = parseTarget(*pf.Target); err != nil {
+			return fail(err)
+		}
+	} else if !slices.Contains(berryFlavors, flavor) {
+		return fail(fmt.Errorf("invalid flavor %q\nChoose from %s", flavor, strings.Join(berryFlavors, ", ")))
+	}
+	if len(rows) < *pf.Berries {
+		return fail(fmt.Errorf("%d berries can't fill a %d berry Poffin", len(rows), *pf.Berries))
+	}
+
+	plans := planPoffins(rows, *pf.Berries, flavor, target, *pf.Top)
+	output.WriteString(renderPoffins(plans, flavor, target))
+	return output.String(), nil
+}
+
+// pickBerries narrows the berries to the --from list, or the --berry.
+func pickBerries(rows []berryRow, from []string, berry string) ([]berryRow, error) {
+	names := from
+	if berry != "" {
+		names = []string{berry}
+	}
+	if len(names) == 0 {
+		return rows, nil
+	}
+
+	var picked []berryRow
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "-berry"))
+		i := slices.IndexFunc(rows, func(r berryRow) bool { return r.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("berry %q not found", name)
+		}
+		if !slices.ContainsFunc(picked, func(r berryRow) bool { return r.Name == name }) {
+			picked = append(picked, rows[i])
+		}
+	}
+	return picked, nil
+}
+
+func berryNames(berries []berryRow) string {
+	names := make([]string, len(berries))
+	for i, b := range berries {
+		names[i] = styling.CapitalizeResourceName(b.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func renderPoffins(plans []poffin, flavor string, target []int) string {
+	var output strings.Builder
+
+	heading := fmt.Sprintf("Strongest %s Poffins", flavor)
+	if target != nil {
+		var parts []string
+		for i, l := range target {
+			if l > 0 {
+				parts = append(parts, fmt.Sprintf("%s %d", berryFlavors[i], l))
+			}
+		}
+		heading = "Poffins closest to " + strings.Join(parts, ", ")
+		if len(parts) == 0 {
+			heading = "Poffins closest to no flavor"
+		}
+	}
+	output.WriteString(styling.StyleBold.Render(heading))
+	output.WriteString("\n")
+	if len(plans) == 0 {
+		output.WriteString("No berries have that flavor.\n")
+		return output.String()
+	}
+
+	headers := []string{"#", "Berries", "Poffin", "Level", "Smooth"}
+	for _, f := range berryFlavors {
+		headers = append(headers, styling.CapitalizeResourceName(f))
+	}
+	cells := make([][]string, len(plans))
+	for i, p := range plans {
+		cells[i] = []string{
+			strconv.Itoa(i + 1),
+			berryNames(p.berries),
+			p.name(),
+			strconv.Itoa(p.level()),
+			strconv.Itoa(p.smoothness),
+		}
+		for j, f := range berryFlavors {
+			cells[i] = append(cells[i], scaledFlavorBar(f, p.levels[j], maxPoffinLevel/2))
+		}
+	}
+	output.WriteString(berryTable(headers, cells))
+	output.WriteString("\n")
+
+	best := plans[0]
+	output.WriteString(styling.StyleBold.Render("Timeline for #1"))
+	output.WriteString("\n")
+	for _, b := range best.berries {
+		fmt.Fprintf(&output, "• %-8s ready in %2dh, water every %dh\n",
+			styling.CapitalizeResourceName(b.Name), harvestTime(b), wateringInterval(b))
+	}
+	fmt.Fprintf(&output, "All berries are ready to cook after %dh.\n", best.readyIn())
+	output.WriteString(lipgloss.NewStyle().Foreground(styling.Gray).Render(
+		"Levels are before the cooking time bonus and any spills or burns."))
+	output.WriteString("\n")
+	return output.String()
+}
+
+func renderHarvest(plans []harvestPlan, yield int) string {
+	var output strings.Builder
+
+	output.WriteString(styling.StyleBold.Render(fmt.Sprintf("Fastest ways to harvest %d berries", yield)))
+	output.WriteString("\n")
+
+	cells := make([][]string, len(plans))
+	for i, p := range plans {
+		cells[i] = []string{
+			styling.CapitalizeResourceName(p.berry.Name),
+			strconv.Itoa(p.plants),
+			fmt.Sprintf("%dh", harvestTime(p.berry)),
+			fmt.Sprintf("%dh", wateringInterval(p.berry)),
+			strconv.Itoa(p.plants * p.berry.MaxHarvest),
+		}
+	}
+	output.WriteString(berryTable([]string{"Berry", "Plants", "Ready In", "Water Every", "Yield"}, cells))
+	output.WriteString("\n")
+	output.WriteString(lipgloss.NewStyle().Foreground(styling.Gray).Render(
+		"Yields assume the soil never dries out."))
+	output.WriteString("\n")
+	return output.String()
+}
@@ -0,0 +1,144 @@
+package berry
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBerry(name string, smoothness, growth int, flavors ...int) berryRow {
+	return berryRow{
+		Berry:   structs.Berry{Name: name, Smoothness: smoothness, GrowthTime: growth, SoilDryness: 15},
+		flavors: flavors,
+	}
+}
+
+func TestCookPoffin(t *testing.T) {
+	// Cheri (spicy 10) and Chesto (dry 10): spicy 10-10 = 0, dry 10-0 = 10,
+	// sour 0-10 goes negative, so every flavor loses 1
+	p := cookPoffin([]berryRow{
+		testBerry("cheri", 25, 3, 10, 0, 0, 0, 0),
+		testBerry("chesto", 25, 3, 0, 10, 0, 0, 0),
+	})
+	assert.Equal(t, []int{0, 9, 0, 0, 0}, p.levels)
+	assert.Equal(t, 23, p.smoothness)
+	assert.Equal(t, 9, p.level())
+	assert.Equal(t, "Dry Poffin", p.name())
+
+	assert.Equal(t, "Foul Poffin", cookPoffin([]berryRow{testBerry("lum", 35, 12, 10, 10, 10, 10, 10)}).name())
+	assert.Equal(t, "Mild Poffin", cookPoffin([]berryRow{testBerry("x", 35, 12, 60, 0, 0, 0, 0)}).name())
+	assert.Equal(t, "Rich Poffin", cookPoffin([]berryRow{testBerry("x", 35, 12, 30, 20, 10, 0, 0)}).name())
+	assert.Equal(t, "Spicy-Bitter Poffin", cookPoffin([]berryRow{testBerry("x", 35, 12, 30, 0, 0, 30, 0)}).name())
+}
+
+func TestHarvestTime(t *testing.T) {
+	assert.Equal(t, 12, harvestTime(testBerry("cheri", 25, 3)))
+	p := cookPoffin([]berryRow{testBerry("cheri", 25, 3, 10, 0, 0, 0, 0), testBerry("spelon", 35, 15, 30, 10, 0, 0, 0)})
+	assert.Equal(t, 60, p.readyIn())
+}
+
+func TestParseTarget(t *testing.T) {
+	levels, err := parseTarget("Spicy=30, dry=10")
+	require.NoError(t, err)
+	assert.Equal(t, []int{30, 10, 0, 0, 0}, levels)
+
+	for _, bad := range []string{"spicy", "salty=10", "sour=-1", "sour=101", "sweet=lots"} {
+		_, err := parseTarget(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	berries := []berryRow{testBerry("a", 0, 0), testBerry("b", 0, 0), testBerry("c", 0, 0), testBerry("d", 0, 0)}
+	var seen []string
+	combinations(berries, 2, func(picked []berryRow) {
+		seen = append(seen, berryNames(picked))
+	})
+	assert.Equal(t, []string{"A, B", "A, C", "A, D", "B, C", "B, D", "C, D"}, seen)
+}
+
+func TestPlanPoffins(t *testing.T) {
+	berries := []berryRow{
+		testBerry("cheri", 25, 3, 10, 0, 0, 0, 0),
+		testBerry("tamato", 30, 8, 20, 10, 0, 0, 0),
+		testBerry("spelon", 35, 15, 30, 10, 0, 0, 0),
+		testBerry("chesto", 25, 3, 0, 10, 0, 0, 0),
+	}
+
+	plans := planPoffins(berries, 2, "spicy", nil, 2)
+	require.Len(t, plans, 2)
+	assert.Equal(t, "Cheri, Spelon", berryNames(plans[0].berries))
+	assert.GreaterOrEqual(t, plans[0].levels[0], plans[1].levels[0])
+
+	plans = planPoffins(berries, 1, "", []int{0, 9, 0, 0, 0}, 1)
+	require.Len(t, plans, 1)
+	assert.Equal(t, "Chesto", berryNames(plans[0].berries))
+
+	assert.Empty(t, planPoffins(berries, 1, "sour", nil, 3), "no berry is sour")
+}
+
+func TestPlanHarvest(t *testing.T) {
+	berries := []berryRow{
+		{Berry: structs.Berry{Name: "slow", GrowthTime: 24, MaxHarvest: 5, SoilDryness: 4}},
+		{Berry: structs.Berry{Name: "fast", GrowthTime: 2, MaxHarvest: 10, SoilDryness: 35}},
+		{Berry: structs.Berry{Name: "fast-few", GrowthTime: 2, MaxHarvest: 5, SoilDryness: 35}},
+	}
+	plans := planHarvest(berries, 21, 5)
+	require.Len(t, plans, 3)
+	assert.Equal(t, "fast", plans[0].berry.Name)
+	assert.Equal(t, 3, plans[0].plants)
+	assert.Equal(t, 5, plans[1].plants)
+	assert.Equal(t, 25, wateringInterval(plans[2].berry))
+}
+
+func TestBerryCommand_Plan(t *testing.T) {
+	output, err := BerryCommand([]string{"berry", "plan", "--flavor", "spicy", "--from", "cheri,spelon-berry,chesto", "-b", "2"})
+	require.NoError(t, err)
+
+	out := styling.StripANSI(output)
+	assert.Contains(t, out, "Strongest spicy Poffins")
+	assert.Contains(t, out, "Cheri, Spelon")
+	assert.Contains(t, out, "Timeline for #1")
+	assert.Regexp(t, `Cheri\s+ready in 12h`, out)
+	assert.Contains(t, out, "ready to cook after 60h", "Spelon takes 15h per stage")
+
+	output, err = BerryCommand([]string{"berry", "plan", "--harvest", "12", "--berry", "oran"})
+	require.NoError(t, err)
+	out = styling.StripANSI(output)
+	assert.Contains(t, out, "Fastest ways to harvest 12 berries")
+	assert.True(t, strings.Contains(out, "Oran") && !strings.Contains(out, "Cheri"))
+
+	output, err = BerryCommand([]string{"berry", "plan", "--harvest", "5", "--berry", "cheri"})
+	require.NoError(t, err)
+	assert.Regexp(t, `Cheri\s+│\s*1\s+│\s*12h`, styling.StripANSI(output), "Cheri grows 3h per stage over 4 stages")
+}
+
+func TestBerryCommand_PlanErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+	}{
+		{"no goal", []string{"berry", "plan"}, "needs one of"},
+		{"two goals", []string{"berry", "plan", "-f", "dry", "--harvest", "10"}, "needs one of"},
+		{"too many berries", []string{"berry", "plan", "-f", "dry", "-b", "5"}, "between 1 and 4"},
+		{"unknown flavor", []string{"berry", "plan", "-f", "salty"}, "invalid flavor"},
+		{"bad target", []string{"berry", "plan", "-t", "dry"}, "invalid target"},
+		{"unknown berry", []string{"berry", "plan", "-f", "dry", "--from", "cheri,fakeberry"}, "not found"},
+		{"too few berries", []string{"berry", "plan", "-f", "dry", "--from", "cheri"}, "can't fill"},
+		{"berry without harvest", []string{"berry", "plan", "-f", "dry", "--berry", "oran"}, "only works with --harvest"},
+		{"argument", []string{"berry", "plan", "oran"}, "only flags"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := BerryCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.contains)
+		})
+	}
+}
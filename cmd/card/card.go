@@ -1,12 +1,17 @@
 package card
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	"github.com/digitalghost-dev/poke-cli/imaging"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
 )
 
 func CardCommand(args []string) (string, error) {
@@ -18,6 +23,13 @@ func CardCommand(args []string) (string, error) {
 				utils.HelpConfig{
 					Description: "Get details about a specific card.",
 					CmdName:     "card",
+					SubCmdName:  "[search <query> | download <set-id>]",
+					Flags: []utils.FlagHelp{
+						{Short: "-a", Long: "--attack", Description: "With search, matches cards by attack name."},
+						{Short: "-i", Long: "--illustrator", Description: "With search, matches cards by illustrator."},
+						{Short: "-c", Long: "--concurrency", Description: "With download, images to fetch at once (1-16)."},
+						{Short: "-d", Long: "--dir", Description: "With download, directory to save to."},
+					},
 				},
 			),
 		)
@@ -27,6 +39,15 @@ func CardCommand(args []string) (string, error) {
 		return output.String(), nil
 	}
 
+	if len(args) > 1 {
+		switch args[1] {
+		case "search":
+			return searchCommand(args[2:])
+		case "download":
+			return downloadCommand(args[2:])
+		}
+	}
+
 	// Validate arguments
 	if err := utils.ValidateArgs(
 		args,
@@ -75,34 +96,137 @@ func CardCommand(args []string) (string, error) {
 				return "", fmt.Errorf("error loading cards: %w", err)
 			}
 
-			for {
-				finalCardsModel, err := tea.NewProgram(cardsMdl).Run()
-				if err != nil {
-					return "", fmt.Errorf("error running cards program: %w", err)
-				}
-
-				cardsResult, ok := finalCardsModel.(cardsModel)
-				if !ok {
-					return "", fmt.Errorf("unexpected model type from cards display: got %T, want cardsModel", finalCardsModel)
-				}
-
-				if cardsResult.ViewImage {
-					// Launch image viewer
-					imageURL := cardsResult.ImageMap[cardsResult.SelectedOption]
-					_, err := tea.NewProgram(ImageRenderer(cardsResult.SelectedOption, imageURL)).Run()
-					if err != nil {
-						fmt.Fprintf(os.Stderr, "Warning: image viewer error: %v\n", err)
-					}
-
-					// Re-launch cards with same state
-					cardsResult.ViewImage = false
-					cardsMdl = cardsResult
-				} else {
-					break
-				}
+			if err := runCardsProgram(cardsMdl); err != nil {
+				return "", err
 			}
 		}
 	}
 
 	return output.String(), nil
 }
+
+// runCardsProgram runs the cards table, re-launching it with the same state
+// after the user closes the image viewer.
+func runCardsProgram(cardsMdl cardsModel) error {
+	// Query the terminal's image support now, while no program is reading stdin.
+	imaging.Detect()
+
+	for {
+		finalCardsModel, err := tea.NewProgram(cardsMdl).Run()
+		if err != nil {
+			return fmt.Errorf("error running cards program: %w", err)
+		}
+
+		cardsResult, ok := finalCardsModel.(cardsModel)
+		if !ok {
+			return fmt.Errorf("unexpected model type from cards display: got %T, want cardsModel", finalCardsModel)
+		}
+
+		if !cardsResult.ViewImage {
+			return nil
+		}
+
+		// Launch image viewer
+		imageURL := cardsResult.ImageMap[cardsResult.SelectedOption]
+		_, err = tea.NewProgram(ImageRenderer(cardsResult.SelectedOption, imageURL)).Run()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: image viewer error: %v\n", err)
+		}
+
+		cardsResult.ViewImage = false
+		cardsMdl = cardsResult
+	}
+}
+
+// searchCommand handles 'poke-cli card search <query> [flags]'
+func searchCommand(args []string) (string, error) {
+	var output strings.Builder
+
+	cf := flags.SetupCardSearchFlagSet()
+	if err := cf.FlagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("card", err))
+		return output.String(), err
+	}
+
+	query := strings.TrimSpace(strings.Join(cf.FlagSet.Args(), " "))
+	if query == "" {
+		err := fmt.Errorf("%s", utils.FormatError("Please declare a search term after the <search> subcommand\nRun 'poke-cli card -h' for more details\nerror: insufficient arguments"))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	field := SearchByName
+	switch {
+	case *cf.Attack && *cf.Illustrator:
+		err := fmt.Errorf("%s", utils.FormatError("Only one of --attack or --illustrator can be used at a time."))
+		output.WriteString(err.Error())
+		return output.String(), err
+	case *cf.Attack:
+		field = SearchByAttack
+	case *cf.Illustrator:
+		field = SearchByIllustrator
+	}
+
+	cardsMdl, err := CardSearch(query, field)
+	if err != nil {
+		return "", fmt.Errorf("error starting card search: %w", err)
+	}
+
+	if err := runCardsProgram(cardsMdl); err != nil {
+		return "", err
+	}
+
+	return output.String(), nil
+}
+
+// downloadCommand handles 'poke-cli card download <set-id> [flags]'
+func downloadCommand(args []string) (string, error) {
+	var output strings.Builder
+
+	cf := flags.SetupCardDownloadFlagSet()
+	if err := cf.FlagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("card", err))
+		return output.String(), err
+	}
+
+	if cf.FlagSet.NArg() != 1 {
+		err := fmt.Errorf("%s", utils.FormatError("Please declare one set ID after the <download> subcommand\nExample: poke-cli card download sv03.5\nRun 'poke-cli card -h' for more details"))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	if *cf.Concurrency < 1 || *cf.Concurrency > maxDownloadWorkers {
+		err := fmt.Errorf("%s", utils.FormatError(fmt.Sprintf("--concurrency must be between 1 and %d.", maxDownloadWorkers)))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	setID := cf.FlagSet.Arg(0)
+	dir := *cf.Dir
+	if dir == "" {
+		dir = imaging.FileName(setID)
+	}
+
+	summary, err := downloadSet(&output, setID, dir, *cf.Concurrency)
+	if err != nil {
+		err = fmt.Errorf("%s", utils.FormatError(err.Error()))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	fmt.Fprintf(&output, "Downloaded %d, skipped %d already saved, %d failed\n", summary.Downloaded, summary.Skipped, len(summary.Failed))
+	for _, entry := range summary.Failed {
+		fmt.Fprintf(&output, "%s %s: %s\n", styling.Red.Render("✖"), entry.Name, entry.Error)
+	}
+	if len(summary.Failed) > 0 {
+		fmt.Fprintf(&output, "Run the same command again to retry the failed cards.\n")
+	}
+
+	return output.String(), nil
+}
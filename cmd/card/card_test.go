@@ -24,6 +24,30 @@ func TestCardCommand(t *testing.T) {
 			wantErr:  false,
 			contains: "FLAGS:",
 		},
+		{
+			name:     "search without a query",
+			args:     []string{"card", "search"},
+			wantErr:  true,
+			contains: "Please declare a search term",
+		},
+		{
+			name:     "search with conflicting flags",
+			args:     []string{"card", "search", "charizard", "--attack", "--illustrator"},
+			wantErr:  true,
+			contains: "Only one of --attack or --illustrator",
+		},
+		{
+			name:     "download without a set id",
+			args:     []string{"card", "download"},
+			wantErr:  true,
+			contains: "Please declare one set ID",
+		},
+		{
+			name:     "download with invalid concurrency",
+			args:     []string{"card", "download", "sv03.5", "--concurrency=0"},
+			wantErr:  true,
+			contains: "--concurrency must be between 1 and 16",
+		},
 		{
 			name:     "invalid args",
 			args:     []string{"card", "invalid-arg"},
@@ -0,0 +1,211 @@
+package card
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/digitalghost-dev/poke-cli/imaging"
+)
+
+const (
+	manifestName       = "manifest.json"
+	maxDownloadWorkers = 16
+)
+
+// downloadManifest records what was saved for a set so an interrupted download can resume.
+type downloadManifest struct {
+	SetID string          `json:"set_id"`
+	Cards []manifestEntry `json:"cards"`
+}
+
+type manifestEntry struct {
+	Name     string `json:"name"`
+	ImageURL string `json:"image_url"`
+	File     string `json:"file,omitempty"`
+	Bytes    int    `json:"bytes,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type downloadSummary struct {
+	Downloaded int
+	Skipped    int
+	Failed     []manifestEntry
+}
+
+func setCardsURL(setID string) string {
+	return "https://uoddayfnfkebrijlpfbh.supabase.co/rest/v1/card_pricing_view" +
+		"?set_id=eq." + url.QueryEscape(setID) +
+		"&select=number_plus_name,image_url&order=localId"
+}
+
+// errCorruptManifest is returned by loadManifest for a manifest that isn't
+// valid JSON, such as one cut short by a crash.
+var errCorruptManifest = errors.New("corrupt manifest")
+
+// loadManifest reads a previous manifest from dir. A missing manifest is not an error.
+func loadManifest(dir string) (downloadManifest, error) {
+	var m downloadManifest
+
+	data, err := os.ReadFile(filepath.Join(dir, manifestName)) // #nosec G304
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return m, err
+	}
+
+	if err := json.Unmarshal(data, &m); err != nil {
+		return downloadManifest{}, fmt.Errorf("could not read %s: %w: %w", manifestName, errCorruptManifest, err)
+	}
+
+	return m, nil
+}
+
+// writeManifest replaces the manifest in dir. It's written to a temporary
+// name first so an interrupted run never leaves a partial manifest behind.
+func writeManifest(dir string, m downloadManifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+manifestName+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o644); err != nil { // #nosec G302
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, manifestName))
+}
+
+// alreadySaved reports whether a previous run saved this card completely.
+func alreadySaved(dir string, entry manifestEntry) bool {
+	if entry.File == "" || entry.Error != "" {
+		return false
+	}
+	info, err := os.Stat(filepath.Join(dir, entry.File))
+	return err == nil && info.Size() == int64(entry.Bytes)
+}
+
+// downloadSet saves every card image in a set to dir, running up to workers
+// downloads at once. Cards recorded in an existing manifest are skipped when
+// their file is still on disk, and the manifest is rewritten as each card finishes.
+func downloadSet(w io.Writer, setID string, dir string, workers int) (downloadSummary, error) {
+	var summary downloadSummary
+
+	body, err := getCardData(setCardsURL(setID))
+	if err != nil {
+		return summary, err
+	}
+
+	var cards []cardData
+	if err := json.Unmarshal(body, &cards); err != nil {
+		return summary, err
+	}
+	if len(cards) == 0 {
+		return summary, fmt.Errorf("no cards found for set %q", setID)
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return summary, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	previous, err := loadManifest(dir)
+	switch {
+	case errors.Is(err, errCorruptManifest):
+		fmt.Fprintf(w, "Warning: %v\nStarting over without it.\n", err)
+	case err != nil:
+		return summary, err
+	}
+	saved := make(map[string]manifestEntry, len(previous.Cards))
+	for _, entry := range previous.Cards {
+		saved[entry.Name] = entry
+	}
+
+	manifest := downloadManifest{SetID: setID, Cards: make([]manifestEntry, len(cards))}
+
+	fmt.Fprintf(w, "Downloading %d card images for set %s to %s\n", len(cards), setID, dir)
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, workers)
+		// warned stops a failing manifest from warning once per card
+		warned bool
+	)
+
+	// Fill in the whole manifest before any worker starts writing it
+	var pending []int
+	for i, card := range cards {
+		if prev, ok := saved[card.NumberPlusName]; ok && prev.ImageURL == card.ImageURL && alreadySaved(dir, prev) {
+			manifest.Cards[i] = prev
+			summary.Skipped++
+			continue
+		}
+		manifest.Cards[i] = manifestEntry{Name: card.NumberPlusName, ImageURL: card.ImageURL}
+		pending = append(pending, i)
+	}
+
+	for _, i := range pending {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, card cardData) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			entry := manifestEntry{Name: card.NumberPlusName, ImageURL: card.ImageURL}
+			data, err := imaging.Download(card.ImageURL, maxCardImageBytes)
+			if err == nil {
+				var path string
+				path, err = imaging.Save(dir, card.NumberPlusName, data)
+				entry.File, entry.Bytes = filepath.Base(path), len(data)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				entry.File, entry.Bytes = "", 0
+				entry.Error = err.Error()
+			} else {
+				summary.Downloaded++
+			}
+			manifest.Cards[i] = entry
+			// Keep the manifest current so an interrupted run can resume
+			if err := writeManifest(dir, manifest); err != nil && !warned {
+				warned = true
+				fmt.Fprintf(w, "Warning: failed to update %s: %v\n", manifestName, err)
+			}
+		}(i, cards[i])
+	}
+
+	wg.Wait()
+
+	for _, entry := range manifest.Cards {
+		if entry.Error != "" {
+			summary.Failed = append(summary.Failed, entry)
+		}
+	}
+
+	if err := writeManifest(dir, manifest); err != nil {
+		return summary, fmt.Errorf("failed to write %s: %w", manifestName, err)
+	}
+
+	return summary, nil
+}
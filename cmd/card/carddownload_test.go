@@ -0,0 +1,182 @@
+package card
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSetCardsURL(t *testing.T) {
+	got := setCardsURL("sv03.5")
+	if !strings.Contains(got, "set_id=eq.sv03.5") || !strings.Contains(got, "select=number_plus_name,image_url") {
+		t.Errorf("unexpected URL: %q", got)
+	}
+}
+
+func TestDownloadSet(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.URL.Path == "/missing.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = png.Encode(w, image.NewRGBA(image.Rect(0, 0, 2, 2)))
+	}))
+	defer server.Close()
+
+	original := getCardData
+	defer func() { getCardData = original }()
+
+	var requested string
+	getCardData = func(url string) ([]byte, error) {
+		requested = url
+		return []byte(fmt.Sprintf(`[
+			{"number_plus_name":"001/165 - Bulbasaur","image_url":"%[1]s/001.png"},
+			{"number_plus_name":"002/165 - Ivysaur","image_url":"%[1]s/002.png"},
+			{"number_plus_name":"003/165 - Venusaur ex","image_url":"%[1]s/missing.png"}
+		]`, server.URL)), nil
+	}
+
+	dir := filepath.Join(t.TempDir(), "sv03.5")
+
+	var out bytes.Buffer
+	summary, err := downloadSet(&out, "sv03.5", dir, 2)
+	if err != nil {
+		t.Fatalf("downloadSet() error = %v", err)
+	}
+	if !strings.Contains(requested, "set_id=eq.sv03.5") {
+		t.Errorf("unexpected cards URL: %q", requested)
+	}
+	if summary.Downloaded != 2 || summary.Skipped != 0 || len(summary.Failed) != 1 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if summary.Failed[0].Name != "003/165 - Venusaur ex" {
+		t.Errorf("unexpected failed card: %+v", summary.Failed[0])
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "001-165_-_Bulbasaur.png")); err != nil {
+		t.Errorf("expected Bulbasaur image on disk: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, manifestName))
+	if err != nil {
+		t.Fatalf("expected a manifest: %v", err)
+	}
+	var manifest downloadManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("invalid manifest: %v", err)
+	}
+	if manifest.SetID != "sv03.5" || len(manifest.Cards) != 3 {
+		t.Fatalf("unexpected manifest: %+v", manifest)
+	}
+	if manifest.Cards[0].File != "001-165_-_Bulbasaur.png" || manifest.Cards[0].Bytes == 0 {
+		t.Errorf("unexpected manifest entry: %+v", manifest.Cards[0])
+	}
+	if !strings.Contains(manifest.Cards[2].Error, "non-200 response") {
+		t.Errorf("expected the failure in the manifest, got %+v", manifest.Cards[2])
+	}
+
+	// A second run only retries the card that failed
+	hits.Store(0)
+	summary, err = downloadSet(&out, "sv03.5", dir, 2)
+	if err != nil {
+		t.Fatalf("downloadSet() resume error = %v", err)
+	}
+	if summary.Skipped != 2 || summary.Downloaded != 0 || len(summary.Failed) != 1 {
+		t.Errorf("unexpected resume summary: %+v", summary)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("resume should only request the failed image, made %d requests", got)
+	}
+
+	// A file removed since the last run is downloaded again
+	if err := os.Remove(filepath.Join(dir, "002-165_-_Ivysaur.png")); err != nil {
+		t.Fatal(err)
+	}
+	summary, err = downloadSet(&out, "sv03.5", dir, 1)
+	if err != nil {
+		t.Fatalf("downloadSet() error = %v", err)
+	}
+	if summary.Downloaded != 1 || summary.Skipped != 1 {
+		t.Errorf("unexpected summary after removing a file: %+v", summary)
+	}
+}
+
+func TestDownloadSet_NoCards(t *testing.T) {
+	original := getCardData
+	defer func() { getCardData = original }()
+
+	getCardData = func(string) ([]byte, error) { return []byte(`[]`), nil }
+
+	_, err := downloadSet(&bytes.Buffer{}, "nope", t.TempDir(), 4)
+	if err == nil || !strings.Contains(err.Error(), "no cards found") {
+		t.Errorf("expected a no cards found error, got %v", err)
+	}
+}
+
+func TestLoadManifest_Corrupt(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, manifestName), []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := loadManifest(dir)
+	if !errors.Is(err, errCorruptManifest) {
+		t.Errorf("expected errCorruptManifest, got %v", err)
+	}
+	if len(m.Cards) != 0 {
+		t.Errorf("expected an empty manifest, got %+v", m)
+	}
+}
+
+func TestDownloadSet_CorruptManifest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = png.Encode(w, image.NewRGBA(image.Rect(0, 0, 2, 2)))
+	}))
+	defer server.Close()
+
+	original := getCardData
+	defer func() { getCardData = original }()
+	getCardData = func(string) ([]byte, error) {
+		return []byte(fmt.Sprintf(`[{"number_plus_name":"001/165 - Bulbasaur","image_url":"%s/001.png"}]`, server.URL)), nil
+	}
+
+	// A run interrupted while writing the manifest
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, manifestName), []byte(`{"set_id": "sv03.5", "cards": [`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	summary, err := downloadSet(&out, "sv03.5", dir, 1)
+	if err != nil {
+		t.Fatalf("downloadSet() error = %v", err)
+	}
+	if summary.Downloaded != 1 {
+		t.Errorf("expected the set to be downloaded again, got %+v", summary)
+	}
+	if !strings.Contains(out.String(), "Warning: could not read manifest.json") {
+		t.Errorf("expected a warning about the manifest, got %q", out.String())
+	}
+	if _, err := loadManifest(dir); err != nil {
+		t.Errorf("expected a readable manifest after the run, got %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), ".tmp") {
+			t.Errorf("temporary file %s was left behind", e.Name())
+		}
+	}
+}
@@ -25,12 +25,15 @@ type cardsModel struct {
 	ImageMap          map[string]string
 	Loading           bool
 	PriceMap          map[string]string
+	Query             string
 	Quitting          bool
 	RegulationMarkMap map[string]string
 	Search            textinput.Model
+	SearchField       string
 	SelectedOption    string
 	SeriesName        string
 	SetID             string
+	SetNameMap        map[string]string
 	Spinner           spinner.Model
 	Table             table.Model
 	TableStyles       table.Styles
@@ -44,6 +47,7 @@ type cardDataMsg struct {
 	imageMap          map[string]string
 	illustratorMap    map[string]string
 	regulationMarkMap map[string]string
+	setNameMap        map[string]string
 	err               error
 }
 
@@ -88,46 +92,65 @@ func fetchCardsCmd(setID string) tea.Cmd {
 			return cardDataMsg{err: err}
 		}
 
-		rows := make([]table.Row, len(allCards))
-		priceMap := make(map[string]string)
-		imageMap := make(map[string]string)
-		illustratorMap := make(map[string]string)
-		regulationMarkMap := make(map[string]string)
-
-		for i, card := range allCards {
-			rows[i] = []string{card.NumberPlusName}
-			if card.MarketPrice != 0 {
-				priceMap[card.NumberPlusName] = fmt.Sprintf("Price: $%.2f", card.MarketPrice)
-			} else {
-				priceMap[card.NumberPlusName] = "Pricing not available"
-			}
+		return buildCardDataMsg(allCards, func(card cardData) string { return card.NumberPlusName })
+	}
+}
 
-			if card.Illustrator != "" {
-				illustratorMap[card.NumberPlusName] = "Illustrator: " + card.Illustrator
-			} else {
-				illustratorMap[card.NumberPlusName] = "Illustrator not available"
-			}
+// buildCardDataMsg turns fetched cards into table rows plus the lookup maps
+// shown in the detail panel. label decides the row text, which is also the map key.
+func buildCardDataMsg(allCards []cardData, label func(cardData) string) cardDataMsg {
+	rows := make([]table.Row, len(allCards))
+	priceMap := make(map[string]string)
+	imageMap := make(map[string]string)
+	illustratorMap := make(map[string]string)
+	regulationMarkMap := make(map[string]string)
+	setNameMap := make(map[string]string)
+
+	for i, card := range allCards {
+		key := label(card)
+		rows[i] = []string{key}
+		if card.MarketPrice != 0 {
+			priceMap[key] = fmt.Sprintf("Price: $%.2f", card.MarketPrice)
+		} else {
+			priceMap[key] = "Pricing not available"
+		}
 
-			if card.RegulationMark != "" {
-				regulationMarkMap[card.NumberPlusName] = "Regulation: " + card.RegulationMark
-			} else {
-				regulationMarkMap[card.NumberPlusName] = "Regulation not available"
-			}
+		if card.Illustrator != "" {
+			illustratorMap[key] = "Illustrator: " + card.Illustrator
+		} else {
+			illustratorMap[key] = "Illustrator not available"
+		}
 
-			imageMap[card.NumberPlusName] = card.ImageURL
+		if card.RegulationMark != "" {
+			regulationMarkMap[key] = "Regulation: " + card.RegulationMark
+		} else {
+			regulationMarkMap[key] = "Regulation not available"
 		}
 
-		return cardDataMsg{
-			allRows:           rows,
-			priceMap:          priceMap,
-			imageMap:          imageMap,
-			illustratorMap:    illustratorMap,
-			regulationMarkMap: regulationMarkMap,
+		if card.SetName != "" {
+			setNameMap[key] = "Set: " + card.SetName
 		}
+
+		imageMap[key] = card.ImageURL
+	}
+
+	return cardDataMsg{
+		allRows:           rows,
+		priceMap:          priceMap,
+		imageMap:          imageMap,
+		illustratorMap:    illustratorMap,
+		regulationMarkMap: regulationMarkMap,
+		setNameMap:        setNameMap,
 	}
 }
 
 func (m cardsModel) Init() tea.Cmd {
+	if m.Query != "" {
+		return tea.Batch(
+			m.Spinner.Tick,
+			fetchSearchCmd(m.Query, m.SearchField),
+		)
+	}
 	return tea.Batch(
 		m.Spinner.Tick,
 		fetchCardsCmd(m.SetID),
@@ -205,6 +228,7 @@ func (m cardsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.ImageMap = msg.imageMap
 		m.IllustratorMap = msg.illustratorMap
 		m.RegulationMarkMap = msg.regulationMarkMap
+		m.SetNameMap = msg.setNameMap
 		m.Search = ti
 		m.Table = t
 		m.TableStyles = styles
@@ -288,6 +312,9 @@ func (m cardsModel) View() tea.View {
 			illustrator := m.IllustratorMap[cardName]
 			regulationMark := m.RegulationMarkMap[cardName]
 			selectedCard = cardName + "\n---\n" + price + "\n---\n" + illustrator + "\n---\n" + regulationMark
+			if setName := m.SetNameMap[cardName]; setName != "" {
+				selectedCard += "\n---\n" + setName
+			}
 		}
 
 		leftContent := lipgloss.JoinVertical(lipgloss.Left, m.Search.View(), m.Table.View())
@@ -321,6 +348,8 @@ type cardData struct {
 	Name           string  `json:"name"`
 	NumberPlusName string  `json:"number_plus_name"`
 	RegulationMark string  `json:"regulation_mark"`
+	SetID          string  `json:"set_id"`
+	SetName        string  `json:"set_name"`
 }
 
 // CardsList returns a minimal model - data fetching happens via Init()
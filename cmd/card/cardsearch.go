@@ -0,0 +1,85 @@
+package card
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const (
+	SearchByName        = "name"
+	SearchByIllustrator = "illustrator"
+	SearchByAttack      = "attack"
+)
+
+// searchColumns maps a search field to the card_pricing_view column it filters on.
+var searchColumns = map[string]string{
+	SearchByName:        "name",
+	SearchByIllustrator: "illustrator",
+	SearchByAttack:      "attack_names",
+}
+
+func searchURL(query string, field string) (string, error) {
+	column, ok := searchColumns[field]
+	if !ok {
+		return "", fmt.Errorf("unknown search field: %q", field)
+	}
+
+	return "https://uoddayfnfkebrijlpfbh.supabase.co/rest/v1/card_pricing_view" +
+		"?select=number_plus_name,market_price,image_url,illustrator,regulation_mark,set_id,set_name" +
+		"&" + column + "=ilike." + url.QueryEscape("*"+query+"*") +
+		"&order=set_id,localId", nil
+}
+
+// searchLabel includes the set ID so printings from different sets stay distinct.
+func searchLabel(card cardData) string {
+	return fmt.Sprintf("%s (%s)", card.NumberPlusName, card.SetID)
+}
+
+// fetchSearchCmd queries every set for cards matching the query and returns a cardDataMsg
+func fetchSearchCmd(query string, field string) tea.Cmd {
+	return func() tea.Msg {
+		searchURL, err := searchURL(query, field)
+		if err != nil {
+			return cardDataMsg{err: err}
+		}
+
+		body, err := getCardData(searchURL)
+		if err != nil {
+			return cardDataMsg{err: err}
+		}
+
+		var allCards []cardData
+		if err := json.Unmarshal(body, &allCards); err != nil {
+			return cardDataMsg{err: err}
+		}
+
+		if len(allCards) == 0 {
+			return cardDataMsg{err: fmt.Errorf("no cards found with %s matching %q", field, query)}
+		}
+
+		return buildCardDataMsg(allCards, searchLabel)
+	}
+}
+
+// CardSearch returns a minimal model for a cross-set search - data fetching happens via Init()
+func CardSearch(query string, field string) (cardsModel, error) {
+	if _, ok := searchColumns[field]; !ok {
+		return cardsModel{}, fmt.Errorf("unknown search field: %q", field)
+	}
+
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = styling.Theme
+
+	return cardsModel{
+		Query:       query,
+		SearchField: field,
+		Loading:     true,
+		Spinner:     s,
+	}, nil
+}
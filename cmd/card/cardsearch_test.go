@@ -0,0 +1,148 @@
+package card
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSearchURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		field    string
+		contains []string
+		wantErr  bool
+	}{
+		{
+			name:     "name search",
+			query:    "charizard",
+			field:    SearchByName,
+			contains: []string{"card_pricing_view", "name=ilike.%2Acharizard%2A", "set_name"},
+		},
+		{
+			name:     "illustrator search escapes spaces",
+			query:    "Mitsuhiro Arita",
+			field:    SearchByIllustrator,
+			contains: []string{"illustrator=ilike.%2AMitsuhiro+Arita%2A"},
+		},
+		{
+			name:     "attack search",
+			query:    "fire spin",
+			field:    SearchByAttack,
+			contains: []string{"attack_names=ilike."},
+		},
+		{
+			name:    "unknown field",
+			query:   "pikachu",
+			field:   "rarity",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := searchURL(tt.query, tt.field)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("searchURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(got, s) {
+					t.Errorf("searchURL() = %q, want it to contain %q", got, s)
+				}
+			}
+		})
+	}
+}
+
+func TestCardSearch_ReturnsLoadingModel(t *testing.T) {
+	model, err := CardSearch("pikachu", SearchByName)
+	if err != nil {
+		t.Fatalf("CardSearch returned error: %v", err)
+	}
+
+	if model.Query != "pikachu" || model.SearchField != SearchByName {
+		t.Errorf("unexpected query/field: %q / %q", model.Query, model.SearchField)
+	}
+
+	if !model.Loading {
+		t.Error("expected Loading to be true")
+	}
+
+	if model.Init() == nil {
+		t.Error("Init() should return commands (spinner tick + search)")
+	}
+}
+
+func TestCardSearch_UnknownField(t *testing.T) {
+	if _, err := CardSearch("pikachu", "rarity"); err == nil {
+		t.Error("expected an error for an unknown search field")
+	}
+}
+
+func TestFetchSearchCmd(t *testing.T) {
+	original := getCardData
+	defer func() { getCardData = original }()
+
+	var requested string
+	getCardData = func(url string) ([]byte, error) {
+		requested = url
+		return []byte(`[
+			{"number_plus_name":"004/102 - Charizard","market_price":350.5,"image_url":"https://example.com/base.png","illustrator":"Mitsuhiro Arita","set_id":"base1","set_name":"Base Set"},
+			{"number_plus_name":"006/165 - Charizard ex","image_url":"https://example.com/151.png","set_id":"sv03.5","set_name":"151"}
+		]`), nil
+	}
+
+	msg, ok := fetchSearchCmd("charizard", SearchByName)().(cardDataMsg)
+	if !ok {
+		t.Fatal("expected cardDataMsg")
+	}
+	if msg.err != nil {
+		t.Fatalf("unexpected error: %v", msg.err)
+	}
+	if !strings.Contains(requested, "name=ilike.") {
+		t.Errorf("expected a name search URL, got %q", requested)
+	}
+
+	if len(msg.allRows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(msg.allRows))
+	}
+
+	key := "004/102 - Charizard (base1)"
+	if msg.allRows[0][0] != key {
+		t.Errorf("expected row label %q, got %q", key, msg.allRows[0][0])
+	}
+	if got := msg.setNameMap[key]; got != "Set: Base Set" {
+		t.Errorf("unexpected set name: %q", got)
+	}
+	if got := msg.priceMap[key]; got != "Price: $350.50" {
+		t.Errorf("unexpected price: %q", got)
+	}
+	if got := msg.imageMap["006/165 - Charizard ex (sv03.5)"]; got != "https://example.com/151.png" {
+		t.Errorf("unexpected image url: %q", got)
+	}
+}
+
+func TestFetchSearchCmd_NoResults(t *testing.T) {
+	original := getCardData
+	defer func() { getCardData = original }()
+
+	getCardData = func(string) ([]byte, error) { return []byte(`[]`), nil }
+
+	msg := fetchSearchCmd("missingno", SearchByName)().(cardDataMsg)
+	if msg.err == nil || !strings.Contains(msg.err.Error(), "no cards found") {
+		t.Errorf("expected a no cards found error, got %v", msg.err)
+	}
+}
+
+func TestFetchSearchCmd_FetchError(t *testing.T) {
+	original := getCardData
+	defer func() { getCardData = original }()
+
+	getCardData = func(string) ([]byte, error) { return nil, errors.New("network error") }
+
+	msg := fetchSearchCmd("pikachu", SearchByAttack)().(cardDataMsg)
+	if msg.err == nil || msg.err.Error() != "network error" {
+		t.Errorf("expected network error, got %v", msg.err)
+	}
+}
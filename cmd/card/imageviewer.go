@@ -4,6 +4,7 @@ import (
 	"charm.land/bubbles/v2/spinner"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/imaging"
 	"github.com/digitalghost-dev/poke-cli/styling"
 )
 
@@ -15,6 +16,9 @@ type imageModel struct {
 	Spinner   spinner.Model
 	ImageData string
 	Protocol  string
+	SaveDir   string
+	SavedPath string
+	SaveErr   error
 }
 
 type imageReadyMsg struct {
@@ -23,6 +27,23 @@ type imageReadyMsg struct {
 	err       error
 }
 
+type imageSavedMsg struct {
+	path string
+	err  error
+}
+
+// saveImageCmd downloads the original image and writes it to dir
+func saveImageCmd(imageURL string, cardName string, dir string) tea.Cmd {
+	return func() tea.Msg {
+		data, err := imaging.Download(imageURL, maxCardImageBytes)
+		if err != nil {
+			return imageSavedMsg{err: err}
+		}
+		path, err := imaging.Save(dir, cardName, data)
+		return imageSavedMsg{path: path, err: err}
+	}
+}
+
 // fetchImageCmd downloads and renders the image asynchronously
 func fetchImageCmd(imageURL string) tea.Cmd {
 	return func() tea.Msg {
@@ -58,6 +79,11 @@ func (m imageModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case imageSavedMsg:
+		m.SavedPath = msg.path
+		m.SaveErr = msg.err
+		return m, nil
+
 	case spinner.TickMsg:
 		var cmd tea.Cmd
 		m.Spinner, cmd = m.Spinner.Update(msg)
@@ -67,6 +93,10 @@ func (m imageModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.String() {
 		case "ctrl+c", "esc":
 			return m, tea.Quit
+		case "s":
+			if !m.Loading && m.Error == nil {
+				return m, saveImageCmd(m.ImageURL, m.CardName, m.SaveDir)
+			}
 		}
 	}
 	return m, nil
@@ -87,6 +117,12 @@ func (m imageModel) View() tea.View {
 			Render(styling.Red.Render(m.Error.Error()))
 	} else {
 		content = m.ImageData
+		switch {
+		case m.SaveErr != nil:
+			content += "\n" + styling.Red.Render("Could not save image: "+m.SaveErr.Error())
+		case m.SavedPath != "":
+			content += "\n" + styling.Green.Render("Saved to "+m.SavedPath)
+		}
 	}
 
 	v := tea.NewView(content)
@@ -102,6 +138,7 @@ func ImageRenderer(cardName string, imageURL string) imageModel {
 	return imageModel{
 		CardName: cardName,
 		ImageURL: imageURL,
+		SaveDir:  ".",
 		Loading:  true,
 		Spinner:  s,
 	}
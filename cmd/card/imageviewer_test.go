@@ -1,6 +1,11 @@
 package card
 
 import (
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -177,3 +182,44 @@ func TestImageModel_Update_SpinnerTick(t *testing.T) {
 		t.Error("Update should return ImageModel")
 	}
 }
+
+func TestImageModel_Update_SaveKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = png.Encode(w, image.NewRGBA(image.Rect(0, 0, 2, 2)))
+	}))
+	defer server.Close()
+
+	model := ImageRenderer("001/198 - Pineco", server.URL)
+	model.Loading = false
+	model.SaveDir = t.TempDir()
+
+	_, cmd := model.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	if cmd == nil {
+		t.Fatal("pressing 's' should return a save command")
+	}
+
+	msg, ok := cmd().(imageSavedMsg)
+	if !ok {
+		t.Fatalf("expected imageSavedMsg, got %T", msg)
+	}
+	if msg.err != nil {
+		t.Fatalf("unexpected save error: %v", msg.err)
+	}
+	if want := filepath.Join(model.SaveDir, "001-198_-_Pineco.png"); msg.path != want {
+		t.Errorf("saved path = %q, want %q", msg.path, want)
+	}
+
+	newModel, _ := model.Update(msg)
+	view := newModel.(imageModel).View().Content
+	if !strings.Contains(view, "Saved to") {
+		t.Errorf("View() should confirm the save, got %q", view)
+	}
+}
+
+func TestImageModel_Update_SaveKeyWhileLoading(t *testing.T) {
+	model := ImageRenderer("001/198 - Pineco", "http://example.com/image.png")
+
+	if _, cmd := model.Update(tea.KeyPressMsg{Code: 's', Text: "s"}); cmd != nil {
+		t.Error("pressing 's' while loading should not save")
+	}
+}
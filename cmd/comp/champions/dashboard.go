@@ -9,12 +9,14 @@ import (
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
 )
 
-var tabs = []string{"Pokémon Overview", "Usage", "Top Teams", "Speed Tiers"}
+var tabs = []string{"Pokémon Overview", "Usage", "Top Teams", "Speed Tiers", "Teammates"}
 
 type dashboardModel struct {
 	activeTab int
 	conn      shell.ConnFunc
 	data      *dashboardData
+	drill     *compInfoRow
+	calc      *speedCalc
 	err       error
 	goBack    bool
 	height    int
@@ -45,13 +47,21 @@ func (m dashboardModel) renderTab(contentWidth int) string {
 
 	switch m.activeTab {
 	case 0:
+		if m.drill != nil {
+			return renderDrillDown(*m.drill, contentWidth)
+		}
 		return renderOverview(m.overview, m.data.CompInfo, contentWidth)
 	case 1:
 		return renderUsage(m.usage, m.data.Usage)
 	case 2:
 		return renderTeamsTable(m.teams, m.data.Teams, contentWidth)
 	case 3:
+		if m.calc != nil {
+			return renderSpeedCalc(m.speed, m.data.SpeedTiers, *m.calc, contentWidth)
+		}
 		return renderSpeedTiers(m.speed, m.data.SpeedTiers)
+	case 4:
+		return renderTeammates(m.data.Usage, m.data.CompInfo, contentWidth)
 	default:
 		return ""
 	}
@@ -64,6 +74,20 @@ func (m dashboardModel) Init() tea.Cmd {
 func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
+		if m.drill != nil {
+			return m.updateDrill(msg)
+		}
+		if m.calc != nil && m.activeTab == 3 {
+			switch msg.String() {
+			case "b":
+				m.calc = nil
+				return m, nil
+			case "+", "=", "-", "_", ">", "<", "n", "i", "s", "t":
+				calc := m.calc.update(msg.String())
+				m.calc = &calc
+				return m, nil
+			}
+		}
 		switch msg.String() {
 		case "ctrl+c", "esc":
 			m.quit = true
@@ -83,6 +107,11 @@ func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.data != nil {
 			switch m.activeTab {
 			case 0:
+				if msg.String() == "enter" && len(m.data.CompInfo) > 0 {
+					row := selectedCompInfo(m.overview, m.data.CompInfo)
+					m.drill = &row
+					return m, nil
+				}
 				var cmd tea.Cmd
 				m.overview, cmd = m.overview.Update(msg)
 				return m, cmd
@@ -95,6 +124,11 @@ func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.teams, cmd = m.teams.Update(msg)
 				return m, cmd
 			case 3:
+				if msg.String() == "enter" && m.calc == nil && len(m.data.SpeedTiers) > 0 {
+					calc := newSpeedCalc(selectedSpeedTier(m.speed, m.data.SpeedTiers))
+					m.calc = &calc
+					return m, nil
+				}
 				var cmd tea.Cmd
 				m.speed, cmd = m.speed.Update(msg)
 				return m, cmd
@@ -126,6 +160,36 @@ func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// updateDrill handles keys while the drill-down is open.
+func (m dashboardModel) updateDrill(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "esc":
+		m.quit = true
+		return m, tea.Quit
+	case "b":
+		m.drill = nil
+	}
+	return m, nil
+}
+
+func (m dashboardModel) menu() string {
+	if m.drill != nil {
+		return drillKeyMenu
+	}
+	if m.data == nil {
+		return shell.KeyMenu
+	}
+	switch {
+	case m.activeTab == 0:
+		return overviewKeyMenu
+	case m.activeTab == 3 && m.calc != nil:
+		return calcKeyMenu
+	case m.activeTab == 3:
+		return speedKeyMenu
+	}
+	return shell.KeyMenu
+}
+
 func (m dashboardModel) View() tea.View {
 	if m.quit {
 		return tea.NewView("\n Goodbye! \n")
@@ -135,7 +199,7 @@ func (m dashboardModel) View() tea.View {
 		return tea.NewView("")
 	}
 
-	body := m.styles.Render(tabs, m.activeTab, m.width, m.renderTab)
+	body := m.styles.RenderWithMenu(tabs, m.activeTab, m.width, m.menu(), m.renderTab)
 
 	v := tea.NewView(body)
 	v.AltScreen = true
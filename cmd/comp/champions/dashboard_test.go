@@ -327,6 +327,7 @@ func TestDashboard_RenderTab(t *testing.T) {
 		{"usage", loaded, 1, "Basculegion"},
 		{"top teams", loaded, 2, "Alice"},
 		{"speed tiers", loaded, 3, "Mega Aerodactyl"},
+		{"teammates without matching usage", loaded, 4, "No data available"},
 	}
 
 	for _, tt := range tests {
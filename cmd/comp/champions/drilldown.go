@@ -0,0 +1,141 @@
+// Drill-down and teammate views for the Champions dashboard.
+
+package champions
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const (
+	overviewKeyMenu = "← → (switch tab) • enter (details) • b (back) • w (web) • ctrl+c | esc (quit)"
+	drillKeyMenu    = "b (back to overview) • ctrl+c | esc (quit)"
+	// matrixSize is how many of the most used Pokémon the teammate matrix covers.
+	matrixSize      = 8
+	matrixCellWidth = 5
+	chartLabelWidth = 18
+)
+
+// statTallies converts usage percents to whole-percent tallies for bar charts.
+func statTallies(stats []commonStat) []shell.Tally {
+	items := make([]shell.Tally, len(stats))
+	for i, s := range stats {
+		items[i] = shell.Tally{Label: truncateName(s.Name, chartLabelWidth), Count: int(math.Round(s.UsagePercent))}
+	}
+	return items
+}
+
+func renderChart(title string, stats []commonStat, width int) string {
+	chart := shell.TopBarChart(statTallies(stats), width, chartLabelWidth)
+	if chart == "" {
+		chart = "-\n"
+	}
+	return lipgloss.NewStyle().Width(width).Render(styling.StyleBold.Render(title) + "\n" + chart)
+}
+
+// renderDrillDown charts every category for one Pokémon, two charts per row.
+func renderDrillDown(row compInfoRow, width int) string {
+	chartWidth := max((width-3)/2, chartLabelWidth+16)
+
+	var b strings.Builder
+	b.WriteString(styling.Theme.Render(row.Pokemon))
+	b.WriteString("\n")
+	b.WriteString(captionStyle.Render("Share of this Pokémon's teams using each option, in percent."))
+	b.WriteString("\n\n")
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top,
+		renderChart("Moves", row.CommonMoves, chartWidth), "   ",
+		renderChart("Items", row.CommonItems, chartWidth)))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top,
+		renderChart("Abilities", row.CommonAbilities, chartWidth), "   ",
+		renderChart("Teammates", row.CommonTeammates, chartWidth)))
+	if row.WebURL != "" {
+		b.WriteString("\n")
+		b.WriteString(detailLine("Link", row.WebURL, width))
+	}
+	return b.String()
+}
+
+// matrixPokemon picks the most used Pokémon that have teammate data, in usage order.
+func matrixPokemon(usage []usageRow, compInfo []compInfoRow) []compInfoRow {
+	byName := make(map[string]compInfoRow, len(compInfo))
+	for _, r := range compInfo {
+		byName[strings.ToLower(r.Pokemon)] = r
+	}
+
+	var top []compInfoRow
+	for _, u := range usage {
+		if r, ok := byName[strings.ToLower(u.Pokemon)]; ok {
+			top = append(top, r)
+			if len(top) == matrixSize {
+				break
+			}
+		}
+	}
+	return top
+}
+
+// teammateMatrix returns how often the column Pokémon appears on the row
+// Pokémon's teams, in whole percent, or -1 when it isn't a common teammate.
+func teammateMatrix(top []compInfoRow) [][]int {
+	matrix := make([][]int, len(top))
+	for i, row := range top {
+		share := make(map[string]float64, len(row.CommonTeammates))
+		for _, t := range row.CommonTeammates {
+			share[strings.ToLower(t.Name)] = t.UsagePercent
+		}
+		matrix[i] = make([]int, len(top))
+		for j, col := range top {
+			pct, ok := share[strings.ToLower(col.Pokemon)]
+			switch {
+			case i == j, !ok:
+				matrix[i][j] = -1
+			default:
+				matrix[i][j] = int(math.Round(pct))
+			}
+		}
+	}
+	return matrix
+}
+
+func renderTeammates(usage []usageRow, compInfo []compInfoRow, width int) string {
+	top := matrixPokemon(usage, compInfo)
+	if len(top) < 2 {
+		return "No data available"
+	}
+	matrix := teammateMatrix(top)
+
+	const labelWidth = 22
+	strong := lipgloss.NewStyle().Foreground(styling.ThemeColor).Bold(true)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-*s", labelWidth, "")
+	for j := range top {
+		fmt.Fprintf(&sb, "%*s", matrixCellWidth, fmt.Sprintf("#%d", j+1))
+	}
+	sb.WriteString("\n")
+	for i, row := range top {
+		fmt.Fprintf(&sb, "%-*s", labelWidth, truncateName(fmt.Sprintf("#%d %s", i+1, row.Pokemon), labelWidth-1))
+		for _, pct := range matrix[i] {
+			cell := fmt.Sprintf("%*s", matrixCellWidth, "·")
+			if pct >= 0 {
+				cell = fmt.Sprintf("%*d", matrixCellWidth, pct)
+				if pct >= 50 {
+					cell = strong.Render(cell)
+				}
+			}
+			sb.WriteString(cell)
+		}
+		sb.WriteString("\n")
+	}
+
+	caption := captionStyle.Width(width).Render(fmt.Sprintf(
+		"Teammates of the %d most used Pokémon. Each row shows how often the Pokémon in each column appears on that row's teams, in percent. · means it isn't a common teammate.",
+		len(top)))
+	return caption + "\n\n" + sb.String()
+}
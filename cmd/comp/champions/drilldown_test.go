@@ -0,0 +1,132 @@
+package champions
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+func TestStatTallies(t *testing.T) {
+	got := statTallies([]commonStat{{Name: "Protect", UsagePercent: 90.5}, {Name: "A Very Long Move Name Indeed", UsagePercent: 0.4}})
+	if got[0].Label != "Protect" || got[0].Count != 91 {
+		t.Errorf("unexpected tally %+v", got[0])
+	}
+	if got[1].Count != 0 || !strings.HasSuffix(got[1].Label, "…") {
+		t.Errorf("expected a truncated, rounded tally, got %+v", got[1])
+	}
+}
+
+func TestRenderDrillDown(t *testing.T) {
+	out := styling.StripANSI(renderDrillDown(testCompInfo()[0], 100))
+	for _, want := range []string{"Miraidon", "Moves", "Protect", "91", "Items", "Choice Specs", "Abilities", "Hadron Engine", "100", "Teammates", "Flutter Mane", "Link: https://example.com/pokemon/1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected drill-down to contain %q", want)
+		}
+	}
+
+	out = styling.StripANSI(renderDrillDown(testCompInfo()[1], 100))
+	if strings.Contains(out, "Link:") {
+		t.Error("expected no link line without a URL")
+	}
+}
+
+func matrixData() ([]usageRow, []compInfoRow) {
+	usage := []usageRow{
+		{Rank: 1, Pokemon: "Miraidon"},
+		{Rank: 2, Pokemon: "Basculegion"},
+		{Rank: 3, Pokemon: "calyrex-shadow"},
+		{Rank: 4, Pokemon: "Flutter Mane"},
+	}
+	info := append(testCompInfo(), compInfoRow{
+		Pokemon:         "Flutter Mane",
+		CommonTeammates: []commonStat{{Name: "Miraidon", UsagePercent: 55.4}, {Name: "Calyrex-Shadow", UsagePercent: 12}},
+	})
+	return usage, info
+}
+
+func TestMatrixPokemon(t *testing.T) {
+	usage, info := matrixData()
+	var names []string
+	for _, r := range matrixPokemon(usage, info) {
+		names = append(names, r.Pokemon)
+	}
+	want := []string{"Miraidon", "Calyrex-Shadow", "Flutter Mane"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("matrixPokemon = %v, want %v", names, want)
+	}
+}
+
+func TestTeammateMatrix(t *testing.T) {
+	usage, info := matrixData()
+	got := teammateMatrix(matrixPokemon(usage, info))
+	want := [][]int{
+		{-1, -1, 70},
+		{40, -1, -1},
+		{55, 12, -1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("teammateMatrix = %v, want %v", got, want)
+	}
+}
+
+func TestRenderTeammates(t *testing.T) {
+	usage, info := matrixData()
+	out := styling.StripANSI(renderTeammates(usage, info, 100))
+	for _, want := range []string{"Teammates of the 3 most used Pokémon", "#1 Miraidon", "#2 Calyrex-Shadow", "#3 Flutter Mane", "   55   12    ·"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected matrix to contain %q\n%s", want, out)
+		}
+	}
+
+	if got := renderTeammates(usage[:1], info, 100); got != "No data available" {
+		t.Errorf("expected no matrix for a single Pokémon, got %q", got)
+	}
+}
+
+func TestDashboard_DrillDown(t *testing.T) {
+	m := loadedTestDashboard()
+	m.activeTab = 0
+	nm, _ := m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	nm, _ = nm.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = nm.(dashboardModel)
+	if m.drill == nil || m.drill.Pokemon != "Calyrex-Shadow" {
+		t.Fatalf("expected the drill-down for the selected Pokémon, got %+v", m.drill)
+	}
+	content := styling.StripANSI(m.View().Content)
+	if !strings.Contains(content, "Astral Barrage") || !strings.Contains(content, drillKeyMenu) {
+		t.Errorf("unexpected drill-down view:\n%s", content)
+	}
+
+	// Tab keys are ignored while the drill-down is open
+	nm, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	if nm.(dashboardModel).activeTab != 0 {
+		t.Error("expected tab to stay on the overview")
+	}
+
+	nm, cmd := m.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	m = nm.(dashboardModel)
+	if m.drill != nil || m.goBack || cmd != nil {
+		t.Error("expected b to close the drill-down only")
+	}
+	if !strings.Contains(styling.StripANSI(m.View().Content), "enter (details)") {
+		t.Error("expected the overview key menu")
+	}
+
+	m.drill = &testCompInfo()[0]
+	nm, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if cmd == nil || !nm.(dashboardModel).quit {
+		t.Error("expected esc to quit from the drill-down")
+	}
+}
+
+func TestDashboard_DrillDown_NoData(t *testing.T) {
+	m := newTestDashboard()
+	nm, _ := m.Update(dataMsg{data: &dashboardData{}})
+	nm, _ = nm.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nm.(dashboardModel).drill != nil {
+		t.Error("expected no drill-down without comp info")
+	}
+}
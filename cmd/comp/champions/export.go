@@ -0,0 +1,90 @@
+package champions
+
+import (
+	"fmt"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+)
+
+// Export fetches the dashboard data and lays it out as tables. Champions data
+// covers the current format rather than a single tournament.
+func Export(conn shell.ConnFunc) (shell.Export, error) {
+	msg, ok := fetchDashboardData(conn)().(dataMsg)
+	if !ok {
+		return shell.Export{}, fmt.Errorf("unexpected message from champions data fetch")
+	}
+	if msg.err != nil {
+		return shell.Export{}, msg.err
+	}
+
+	return shell.Export{
+		Competition: "champions",
+		Tables:      exportTables(msg.data),
+	}, nil
+}
+
+func exportTables(data *dashboardData) []shell.Table {
+	usage := shell.Table{
+		Name:    "usage",
+		Columns: []string{"rank", "pokemon", "usage_percent"},
+		Rows:    make([][]any, len(data.Usage)),
+	}
+	for i, r := range data.Usage {
+		usage.Rows[i] = []any{r.Rank, r.Pokemon, r.UsagePercent}
+	}
+
+	speedTiers := shell.Table{
+		Name: "speed_tiers",
+		Columns: []string{
+			"rank", "pokemon", "base_spe", "neutral_0_sp", "neutral_32_sp",
+			"neg_spe_0_sp", "max_speed", "max_scarf", "neutral_32_scarf",
+		},
+		Rows: make([][]any, len(data.SpeedTiers)),
+	}
+	for i, r := range data.SpeedTiers {
+		speedTiers.Rows[i] = []any{
+			r.Rank, r.Pokemon, r.BaseSpe, r.Neutral0, r.Neutral252,
+			r.NegMin, r.Max, r.MaxScarf, r.NeutralScarf,
+		}
+	}
+
+	teams := shell.Table{
+		Name:    "teams",
+		Columns: []string{"player", "record", "tournament", "archetypes", "pokemon", "web_url"},
+		Rows:    make([][]any, len(data.Teams)),
+	}
+	for i, r := range data.Teams {
+		teams.Rows[i] = []any{r.Player, r.Record, r.Tournament, orEmpty(r.Archetypes), orEmpty(r.Pokemon), r.WebURL}
+	}
+
+	// One row per common move, ability, item or teammate
+	compInfo := shell.Table{
+		Name:    "comp_info",
+		Columns: []string{"pokemon", "category", "name", "usage_percent"},
+	}
+	for _, r := range data.CompInfo {
+		for _, group := range []struct {
+			category string
+			stats    []commonStat
+		}{
+			{"move", r.CommonMoves},
+			{"ability", r.CommonAbilities},
+			{"item", r.CommonItems},
+			{"teammate", r.CommonTeammates},
+		} {
+			for _, s := range group.stats {
+				compInfo.Rows = append(compInfo.Rows, []any{r.Pokemon, group.category, s.Name, s.UsagePercent})
+			}
+		}
+	}
+
+	return []shell.Table{usage, speedTiers, teams, compInfo}
+}
+
+// orEmpty keeps missing lists as [] in JSON output.
+func orEmpty(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
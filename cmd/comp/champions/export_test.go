@@ -0,0 +1,68 @@
+package champions
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	conn := func(url string) ([]byte, error) {
+		switch url {
+		case compInfoURL:
+			return []byte(`[{"pokemon":"Miraidon","common_moves":[{"name":"Protect","usage_percent":90.5}],"common_items":[{"name":"Choice Specs","usage_percent":45.2}]}]`), nil
+		case topTeamsURL:
+			return []byte(`[{"author":"Alice","record":"7-1","pokemon":["Miraidon"]}]`), nil
+		case usageURL:
+			return []byte(`[{"rank":1,"pokemon":"Basculegion","usage_percent":51.5}]`), nil
+		case speedTiersURL:
+			return []byte(`[{"rank":1,"pokemon":"Mega Aerodactyl","base_spe":150,"max_speed":222}]`), nil
+		}
+		t.Fatalf("unexpected URL %q", url)
+		return nil, nil
+	}
+
+	e, err := Export(conn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.Competition != "champions" || e.Tournament != "" {
+		t.Errorf("unexpected export metadata: %+v", e)
+	}
+
+	names := e.TableNames()
+	want := []string{"usage", "speed_tiers", "teams", "comp_info"}
+	if len(names) != len(want) {
+		t.Fatalf("expected tables %v, got %v", want, names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("expected tables %v, got %v", want, names)
+		}
+	}
+
+	if usage, _ := e.Table("usage"); usage.Rows[0][2] != 51.5 {
+		t.Errorf("unexpected usage row: %v", usage.Rows[0])
+	}
+	if speed, _ := e.Table("speed_tiers"); speed.Rows[0][2] != 150 || speed.Rows[0][6] != 222 {
+		t.Errorf("unexpected speed tier row: %v", speed.Rows[0])
+	}
+	teams, _ := e.Table("teams")
+	if archetypes, ok := teams.Rows[0][3].([]string); !ok || archetypes == nil {
+		t.Errorf("expected missing archetypes as an empty list, got %v", teams.Rows[0][3])
+	}
+
+	info, _ := e.Table("comp_info")
+	if len(info.Rows) != 2 {
+		t.Fatalf("expected one comp_info row per stat, got %d", len(info.Rows))
+	}
+	if info.Rows[0][1] != "move" || info.Rows[1][1] != "item" || info.Rows[1][2] != "Choice Specs" {
+		t.Errorf("unexpected comp_info rows: %v", info.Rows)
+	}
+}
+
+func TestExport_ConnectionError(t *testing.T) {
+	conn := func(string) ([]byte, error) { return nil, errors.New("network error") }
+	if _, err := Export(conn); err == nil || err.Error() != "network error" {
+		t.Errorf("expected network error, got %v", err)
+	}
+}
@@ -0,0 +1,277 @@
+// Speed calculator for the Speed Tiers tab. It uses the same formula as the
+// speed command and compares the result against the tier list.
+
+package champions
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/table"
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/speed"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const (
+	speedKeyMenu = "← → (switch tab) • enter (speed calc) • b (back) • w (web) • ctrl+c | esc (quit)"
+	calcKeyMenu  = "↑ ↓ (target) • + - < > (EVs) • n (nature) • i (IV) • s (scenario) • t (target spread) • b (close) • esc (quit)"
+	// calcLevel matches the level 50 tier list.
+	calcLevel = 50
+	maxEVs    = 252
+	// calcThreats caps how many outsped threats are listed by name.
+	calcThreats = 8
+)
+
+type speedScenario struct {
+	name      string
+	modifiers []string
+	trickRoom bool
+}
+
+var speedScenarios = []speedScenario{
+	{name: "No modifiers"},
+	{name: "Choice Scarf", modifiers: []string{"Choice Scarf"}},
+	{name: "Tailwind", modifiers: []string{"Tailwind"}},
+	{name: "Trick Room", trickRoom: true},
+}
+
+// speedBenchmark is the spread assumed for every Pokémon on the tier list.
+type speedBenchmark struct {
+	name  string
+	speed func(speedTierRow) int
+}
+
+var speedBenchmarks = []speedBenchmark{
+	{"max speed (252 EVs, +Spe)", func(r speedTierRow) int { return r.Max }},
+	{"neutral (252 EVs)", func(r speedTierRow) int { return r.Neutral252 }},
+	{"uninvested (0 EVs)", func(r speedTierRow) int { return r.Neutral0 }},
+	{"minimum (0 EVs, -Spe)", func(r speedTierRow) int { return r.NegMin }},
+	{"max speed + Scarf", func(r speedTierRow) int { return r.MaxScarf }},
+}
+
+var (
+	natureNames       = map[int]string{1: "+Spe", 0: "Neutral", -1: "-Spe"}
+	natureMultipliers = map[int]float64{1: 1.1, 0: 1.0, -1: 0.9}
+)
+
+// speedCalc is a custom spread for one Pokémon from the tier list.
+type speedCalc struct {
+	mon       speedTierRow
+	ev        int
+	iv        int
+	nature    int
+	scenario  int
+	benchmark int
+}
+
+func newSpeedCalc(mon speedTierRow) speedCalc {
+	return speedCalc{mon: mon, ev: maxEVs, iv: 31, nature: 1}
+}
+
+// statAt is the spread's speed with the given EVs.
+func (c speedCalc) statAt(ev int) int {
+	return speed.Calculate(c.mon.BaseSpe, c.iv, ev, calcLevel, natureMultipliers[c.nature], speed.ModifierMultiplier(speedScenarios[c.scenario].modifiers))
+}
+
+func (c speedCalc) speed() int {
+	return c.statAt(c.ev)
+}
+
+func (c speedCalc) targetSpeed(r speedTierRow) int {
+	return speedBenchmarks[c.benchmark].speed(r)
+}
+
+// movesFirst reports whether a Pokémon at stat speed moves before one at
+// target speed, which flips under Trick Room.
+func (c speedCalc) movesFirst(stat, target int) bool {
+	if speedScenarios[c.scenario].trickRoom {
+		return stat < target
+	}
+	return stat > target
+}
+
+// others is the tier list without the calculator's own Pokémon.
+func (c speedCalc) others(rows []speedTierRow) []speedTierRow {
+	out := make([]speedTierRow, 0, len(rows))
+	for _, r := range rows {
+		if r.Pokemon != c.mon.Pokemon {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// slot is the spread's place among the other Pokémon on the tier list,
+// counting only those that are strictly faster.
+func (c speedCalc) slot(rows []speedTierRow) int {
+	faster := 0
+	for _, r := range c.others(rows) {
+		if c.targetSpeed(r) > c.speed() {
+			faster++
+		}
+	}
+	return faster + 1
+}
+
+// outsped lists the Pokémon the spread moves before, closest speeds first.
+func (c speedCalc) outsped(rows []speedTierRow) []speedTierRow {
+	var out []speedTierRow
+	for _, r := range c.others(rows) {
+		if c.movesFirst(c.speed(), c.targetSpeed(r)) {
+			out = append(out, r)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		di, dj := abs(c.targetSpeed(out[i])-c.speed()), abs(c.targetSpeed(out[j])-c.speed())
+		return di < dj
+	})
+	return out
+}
+
+// evsToOutspeed finds the fewest EVs that move before the target. Under Trick
+// Room it finds the most EVs that still move first, since every EV past that
+// point only makes the Pokémon faster.
+func (c speedCalc) evsToOutspeed(target int) (int, bool) {
+	if speedScenarios[c.scenario].trickRoom {
+		for ev := maxEVs; ev >= 0; ev -= 4 {
+			if c.movesFirst(c.statAt(ev), target) {
+				return ev, true
+			}
+		}
+		return 0, false
+	}
+	for ev := 0; ev <= maxEVs; ev += 4 {
+		if c.movesFirst(c.statAt(ev), target) {
+			return ev, true
+		}
+	}
+	return 0, false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func (c speedCalc) update(key string) speedCalc {
+	switch key {
+	case "+", "=":
+		c.ev = min(c.ev+4, maxEVs)
+	case "-", "_":
+		c.ev = max(c.ev-4, 0)
+	case ">":
+		c.ev = maxEVs
+	case "<":
+		c.ev = 0
+	case "n":
+		// +Spe, then neutral, then -Spe
+		c.nature--
+		if c.nature < -1 {
+			c.nature = 1
+		}
+	case "i":
+		if c.iv == 31 {
+			c.iv = 0
+		} else {
+			c.iv = 31
+		}
+	case "s":
+		c.scenario = (c.scenario + 1) % len(speedScenarios)
+	case "t":
+		c.benchmark = (c.benchmark + 1) % len(speedBenchmarks)
+	}
+	return c
+}
+
+func (c speedCalc) render(rows []speedTierRow, target speedTierRow) string {
+	scenario := speedScenarios[c.scenario]
+	total := len(c.others(rows)) + 1
+
+	var b strings.Builder
+	b.WriteString(styling.Theme.Render("Speed Calculator"))
+	b.WriteString("\n")
+	b.WriteString(styling.StyleBold.Render(c.mon.Pokemon))
+	b.WriteString("\n\n")
+	for _, line := range []struct {
+		label, value string
+	}{
+		{"Base Speed", strconv.Itoa(c.mon.BaseSpe)},
+		{"EVs", strconv.Itoa(c.ev)},
+		{"IVs", strconv.Itoa(c.iv)},
+		{"Nature", natureNames[c.nature]},
+		{"Scenario", scenario.name},
+	} {
+		b.WriteString(calcLine(line.label, line.value))
+		b.WriteString("\n")
+	}
+	b.WriteString(speedStatLine("Speed", c.speed()))
+	b.WriteString("\n\n")
+
+	benchmark := speedBenchmarks[c.benchmark].name
+	b.WriteString(fmt.Sprintf("Slots in at #%d of %d against %s.\n", c.slot(rows), total, benchmark))
+
+	outsped := c.outsped(rows)
+	verb := "Outspeeds"
+	if scenario.trickRoom {
+		verb = "Moves first under Trick Room against"
+	}
+	b.WriteString(fmt.Sprintf("%s %d of %d.\n", verb, len(outsped), total-1))
+	for _, r := range outsped[:min(len(outsped), calcThreats)] {
+		b.WriteString(fmt.Sprintf("  %s (%d)\n", r.Pokemon, c.targetSpeed(r)))
+	}
+	if len(outsped) > calcThreats {
+		b.WriteString(captionStyle.Render(fmt.Sprintf("  and %d more", len(outsped)-calcThreats)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(c.renderTarget(target))
+	return b.String()
+}
+
+func (c speedCalc) renderTarget(target speedTierRow) string {
+	if target.Pokemon == "" {
+		return ""
+	}
+
+	targetSpeed := c.targetSpeed(target)
+	title := fmt.Sprintf("Target: %s (%d)", target.Pokemon, targetSpeed)
+	var verdict string
+	ev, ok := c.evsToOutspeed(targetSpeed)
+	switch {
+	case !ok && speedScenarios[c.scenario].trickRoom:
+		verdict = "Can't move first under Trick Room, even with 0 EVs."
+	case !ok:
+		verdict = fmt.Sprintf("Can't outspeed, even with %d EVs.", maxEVs)
+	case speedScenarios[c.scenario].trickRoom:
+		verdict = fmt.Sprintf("Moves first under Trick Room with up to %d EVs (%d).", ev, c.statAt(ev))
+	default:
+		verdict = fmt.Sprintf("Outspeeds with %d EVs (%d).", ev, c.statAt(ev))
+	}
+	if c.speed() == targetSpeed {
+		verdict += " Your current spread speed ties."
+	}
+	return styling.StyleBold.Render(title) + "\n" + verdict
+}
+
+func calcLine(label, value string) string {
+	const labelWidth = 19
+	return fmt.Sprintf("%-*s%s", labelWidth, label, styling.StyleBold.Render(value))
+}
+
+func renderSpeedCalc(speedTable table.Model, rows []speedTierRow, calc speedCalc, width int) string {
+	if len(rows) == 0 {
+		return "No data available"
+	}
+
+	caption := captionStyle.Width(width).Render("Select a target in the table. Every Pokémon on the tier list is assumed to run the target spread; press t to change it.")
+	detailWidth := max(width-speedTable.Width()-2, 30)
+	detail := lipgloss.NewStyle().Width(detailWidth).Render(calc.render(rows, selectedSpeedTier(speedTable, rows)))
+	body := lipgloss.JoinHorizontal(lipgloss.Top, speedTable.View(), "  ", detail)
+	return caption + "\n\n" + body
+}
@@ -0,0 +1,219 @@
+package champions
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+func calcTiers() []speedTierRow {
+	return append(testSpeedTiers(), speedTierRow{
+		Rank: 30, Pokemon: "Incineroar", BaseSpe: 60, Neutral0: 80, Neutral252: 112, NegMin: 72, Max: 123, MaxScarf: 184, NeutralScarf: 168,
+	})
+}
+
+func scenarioIndex(t *testing.T, name string) int {
+	t.Helper()
+	for i, s := range speedScenarios {
+		if s.name == name {
+			return i
+		}
+	}
+	t.Fatalf("no scenario %q", name)
+	return 0
+}
+
+func TestSpeedCalc_MatchesTierList(t *testing.T) {
+	for _, r := range calcTiers() {
+		c := newSpeedCalc(r)
+		if got := c.speed(); got != r.Max {
+			t.Errorf("%s max speed = %d, want %d", r.Pokemon, got, r.Max)
+		}
+		c.scenario = scenarioIndex(t, "Choice Scarf")
+		if got := c.speed(); got != r.MaxScarf {
+			t.Errorf("%s max scarf speed = %d, want %d", r.Pokemon, got, r.MaxScarf)
+		}
+		c = newSpeedCalc(r)
+		c.ev, c.nature = 0, -1
+		if got := c.speed(); got != r.NegMin {
+			t.Errorf("%s min speed = %d, want %d", r.Pokemon, got, r.NegMin)
+		}
+	}
+}
+
+func TestSpeedCalc_SlotAndOutsped(t *testing.T) {
+	rows := calcTiers()
+	c := newSpeedCalc(rows[2])
+	if got := c.slot(rows); got != 3 {
+		t.Errorf("slot = %d, want 3", got)
+	}
+	if got := c.outsped(rows); len(got) != 0 {
+		t.Errorf("expected no outsped threats, got %v", got)
+	}
+
+	c.scenario = scenarioIndex(t, "Tailwind")
+	if got := c.slot(rows); got != 1 {
+		t.Errorf("Tailwind slot = %d, want 1", got)
+	}
+	outsped := c.outsped(rows)
+	if len(outsped) != 2 || outsped[0].Pokemon != "Mega Aerodactyl" || outsped[1].Pokemon != "Aerodactyl" {
+		t.Errorf("expected the closest threat first, got %v", outsped)
+	}
+
+	c.scenario = scenarioIndex(t, "Trick Room")
+	if got := c.outsped(rows); len(got) != 2 {
+		t.Errorf("expected Trick Room to move first against both, got %v", got)
+	}
+}
+
+func TestSpeedCalc_EVsToOutspeed(t *testing.T) {
+	incineroar := newSpeedCalc(calcTiers()[2])
+
+	if _, ok := incineroar.evsToOutspeed(200); ok {
+		t.Error("expected Incineroar not to outspeed 200 without modifiers")
+	}
+
+	incineroar.scenario = scenarioIndex(t, "Tailwind")
+	ev, ok := incineroar.evsToOutspeed(200)
+	if !ok || ev != 92 {
+		t.Errorf("Tailwind EVs = %d, %v, want 92", ev, ok)
+	}
+	if incineroar.statAt(ev) <= 200 || incineroar.statAt(ev-4) > 200 {
+		t.Errorf("expected %d EVs to be the fewest that outspeed", ev)
+	}
+
+	incineroar.scenario = scenarioIndex(t, "Trick Room")
+	if ev, ok := incineroar.evsToOutspeed(200); !ok || ev != maxEVs {
+		t.Errorf("Trick Room EVs = %d, %v, want %d", ev, ok, maxEVs)
+	}
+	if ev, ok := incineroar.evsToOutspeed(115); !ok || incineroar.statAt(ev) >= 115 || incineroar.statAt(ev+4) < 115 {
+		t.Errorf("expected the most EVs that stay under 115, got %d, %v", ev, ok)
+	}
+
+	aerodactyl := newSpeedCalc(calcTiers()[1])
+	aerodactyl.scenario, aerodactyl.ev, aerodactyl.iv, aerodactyl.nature = scenarioIndex(t, "Trick Room"), 0, 0, -1
+	if _, ok := aerodactyl.evsToOutspeed(72); ok {
+		t.Error("expected Aerodactyl to be too fast under Trick Room")
+	}
+}
+
+func TestSpeedCalc_Update(t *testing.T) {
+	c := newSpeedCalc(calcTiers()[0])
+
+	c = c.update("+")
+	if c.ev != maxEVs {
+		t.Errorf("expected EVs to stop at %d, got %d", maxEVs, c.ev)
+	}
+	c = c.update("-").update("-")
+	if c.ev != 244 {
+		t.Errorf("expected 244 EVs, got %d", c.ev)
+	}
+	if c = c.update("<"); c.ev != 0 {
+		t.Errorf("expected 0 EVs, got %d", c.ev)
+	}
+	if c = c.update("-"); c.ev != 0 {
+		t.Errorf("expected EVs to stop at 0, got %d", c.ev)
+	}
+	if c = c.update(">"); c.ev != maxEVs {
+		t.Errorf("expected %d EVs, got %d", maxEVs, c.ev)
+	}
+
+	var natures []int
+	for range 3 {
+		c = c.update("n")
+		natures = append(natures, c.nature)
+	}
+	if natures[0] != 0 || natures[1] != -1 || natures[2] != 1 {
+		t.Errorf("unexpected nature cycle %v", natures)
+	}
+
+	if c = c.update("i"); c.iv != 0 {
+		t.Errorf("expected 0 IVs, got %d", c.iv)
+	}
+	if c = c.update("i"); c.iv != 31 {
+		t.Errorf("expected 31 IVs, got %d", c.iv)
+	}
+
+	for range len(speedScenarios) {
+		c = c.update("s")
+	}
+	for range len(speedBenchmarks) + 1 {
+		c = c.update("t")
+	}
+	if c.scenario != 0 || c.benchmark != 1 {
+		t.Errorf("expected scenario and benchmark to wrap, got %d, %d", c.scenario, c.benchmark)
+	}
+}
+
+func TestSpeedCalc_Render(t *testing.T) {
+	rows := calcTiers()
+	c := newSpeedCalc(rows[2])
+	c.scenario = scenarioIndex(t, "Tailwind")
+	out := styling.StripANSI(c.render(rows, rows[1]))
+	for _, want := range []string{
+		"Incineroar", "Tailwind", "246",
+		"Slots in at #1 of 3 against max speed (252 EVs, +Spe).",
+		"Outspeeds 2 of 2.", "Mega Aerodactyl (222)",
+		"Target: Aerodactyl (200)", "Outspeeds with 92 EVs (202).",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected calculator to contain %q\n%s", want, out)
+		}
+	}
+
+	c.scenario = scenarioIndex(t, "No modifiers")
+	if out := styling.StripANSI(c.renderTarget(rows[1])); !strings.Contains(out, "Can't outspeed, even with 252 EVs.") {
+		t.Errorf("unexpected verdict %q", out)
+	}
+	if out := styling.StripANSI(c.renderTarget(rows[2])); !strings.Contains(out, "ties") {
+		t.Errorf("expected a speed tie note, got %q", out)
+	}
+}
+
+func TestDashboard_SpeedCalc(t *testing.T) {
+	m := newTestDashboard()
+	nm, _ := m.Update(dataMsg{data: &dashboardData{SpeedTiers: calcTiers()}})
+	m = nm.(dashboardModel)
+	m.activeTab = 3
+
+	press := func(keys ...tea.KeyPressMsg) tea.Cmd {
+		var cmd tea.Cmd
+		for _, k := range keys {
+			nm, cmd = m.Update(k)
+			m = nm.(dashboardModel)
+		}
+		return cmd
+	}
+
+	if !strings.Contains(styling.StripANSI(m.View().Content), "enter (speed calc)") {
+		t.Error("expected the speed tiers key menu")
+	}
+
+	press(tea.KeyPressMsg{Code: tea.KeyDown}, tea.KeyPressMsg{Code: tea.KeyDown}, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.calc == nil || m.calc.mon.Pokemon != "Incineroar" {
+		t.Fatalf("expected a calculator for the selected Pokémon, got %+v", m.calc)
+	}
+
+	press(tea.KeyPressMsg{Code: tea.KeyUp}, tea.KeyPressMsg{Code: 's', Text: "s"}, tea.KeyPressMsg{Code: 's', Text: "s"})
+	if m.speed.Cursor() != 1 || speedScenarios[m.calc.scenario].name != "Tailwind" {
+		t.Errorf("expected the target and scenario to change, got cursor %d, scenario %d", m.speed.Cursor(), m.calc.scenario)
+	}
+	content := styling.StripANSI(m.View().Content)
+	if !strings.Contains(content, "Target: Aerodactyl") || !strings.Contains(content, "b (close)") {
+		t.Errorf("unexpected calculator view:\n%s", content)
+	}
+
+	if cmd := press(tea.KeyPressMsg{Code: 'b', Text: "b"}); cmd != nil || m.calc != nil || m.goBack {
+		t.Error("expected b to close the calculator only")
+	}
+
+	// Elsewhere, b still goes back
+	press(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m.activeTab = 1
+	press(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	if !m.goBack {
+		t.Error("expected b to go back outside the Speed Tiers tab")
+	}
+}
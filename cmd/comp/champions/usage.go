@@ -0,0 +1,74 @@
+package champions
+
+import (
+	"strings"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+)
+
+// Common is a move or item and the share of a Pokémon's sets that run it.
+type Common struct {
+	Name         string
+	UsagePercent float64
+}
+
+// Usage is how one Pokémon is used in the Champions format.
+type Usage struct {
+	Pokemon      string
+	Rank         int
+	UsagePercent float64
+	Moves        []Common
+	Items        []Common
+}
+
+// Percent is the share of sets running the named move or item, or 0.
+func Percent(stats []Common, name string) float64 {
+	for _, s := range stats {
+		if strings.EqualFold(s.Name, name) {
+			return s.UsagePercent
+		}
+	}
+	return 0
+}
+
+// usageKey matches "Flutter Mane" in the Champions data to "flutter-mane" from PokeAPI.
+func usageKey(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+// PokemonUsage looks up a Pokémon in the Champions usage and comp info data.
+// ok is false when the Pokémon isn't used in the format.
+func PokemonUsage(conn shell.ConnFunc, pokemon string) (usage Usage, ok bool, err error) {
+	rows, err := fetchUsage(conn)
+	if err != nil {
+		return Usage{}, false, err
+	}
+	key := usageKey(pokemon)
+	for _, r := range rows {
+		if usageKey(r.Pokemon) == key {
+			usage = Usage{Pokemon: r.Pokemon, Rank: r.Rank, UsagePercent: r.UsagePercent}
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return Usage{}, false, nil
+	}
+
+	info, err := fetchCompInfo(conn)
+	if err != nil {
+		return Usage{}, false, err
+	}
+	for _, r := range info {
+		if usageKey(r.Pokemon) != key {
+			continue
+		}
+		for _, m := range r.CommonMoves {
+			usage.Moves = append(usage.Moves, Common(m))
+		}
+		for _, i := range r.CommonItems {
+			usage.Items = append(usage.Items, Common(i))
+		}
+	}
+	return usage, true, nil
+}
@@ -0,0 +1,48 @@
+package champions
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPokemonUsage(t *testing.T) {
+	conn := func(url string) ([]byte, error) {
+		switch url {
+		case usageURL:
+			return []byte(`[{"rank":1,"pokemon":"Basculegion","usage_percent":51.5},{"rank":4,"pokemon":"Flutter Mane","usage_percent":30.2}]`), nil
+		case compInfoURL:
+			return []byte(`[{"pokemon":"Flutter Mane","common_moves":[{"name":"Moonblast","usage_percent":95.1},{"name":"Trick Room","usage_percent":12}],"common_items":[{"name":"Choice Specs","usage_percent":40.5}]}]`), nil
+		}
+		t.Fatalf("unexpected URL %q", url)
+		return nil, nil
+	}
+
+	usage, ok, err := PokemonUsage(conn, "flutter-mane")
+	if err != nil || !ok {
+		t.Fatalf("expected usage, got ok=%v err=%v", ok, err)
+	}
+	if usage.Pokemon != "Flutter Mane" || usage.Rank != 4 || usage.UsagePercent != 30.2 {
+		t.Errorf("unexpected usage: %+v", usage)
+	}
+	if len(usage.Moves) != 2 || len(usage.Items) != 1 {
+		t.Fatalf("unexpected moves or items: %+v", usage)
+	}
+	if got := Percent(usage.Moves, "trick room"); got != 12 {
+		t.Errorf("expected Trick Room on 12%% of sets, got %v", got)
+	}
+	if got := Percent(usage.Items, "Choice Scarf"); got != 0 {
+		t.Errorf("expected no Choice Scarf, got %v", got)
+	}
+
+	_, ok, err = PokemonUsage(conn, "magikarp")
+	if err != nil || ok {
+		t.Errorf("expected Magikarp to be unused, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestPokemonUsage_ConnectionError(t *testing.T) {
+	conn := func(string) ([]byte, error) { return nil, errors.New("offline") }
+	if _, _, err := PokemonUsage(conn, "miraidon"); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -6,11 +6,36 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/digitalghost-dev/poke-cli/cmd/comp/champions"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/plugin"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
 	"github.com/digitalghost-dev/poke-cli/cmd/comp/tcg"
 	"github.com/digitalghost-dev/poke-cli/cmd/comp/vgc"
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
 )
 
+// loadPlugins reads the plugin files from the config dir. Swapped out in tests.
+var loadPlugins = func() ([]plugin.Plugin, error) {
+	dir, err := plugin.Dir()
+	if err != nil {
+		return nil, err
+	}
+	return plugin.Load(dir)
+}
+
+// findPlugin loads the plugins and returns the one with the given ID.
+func findPlugin(id string) (plugin.Plugin, bool, error) {
+	plugins, err := loadPlugins()
+	if err != nil {
+		return plugin.Plugin{}, false, err
+	}
+	for _, p := range plugins {
+		if p.ID == id {
+			return p, true, nil
+		}
+	}
+	return plugin.Plugin{}, false, nil
+}
+
 func CompCommand(args []string) (string, error) {
 	var output strings.Builder
 
@@ -20,6 +45,15 @@ func CompCommand(args []string) (string, error) {
 				utils.HelpConfig{
 					Description: "Get details about competitive Pokémon.",
 					CmdName:     "comp",
+					SubCmdName:  "[<tcg | vgc | champions | plugin> export | watch]",
+					Flags: []utils.FlagHelp{
+						{Short: "-t", Long: "--tournament", Description: "With export, tournament location. Defaults to the latest."},
+						{Short: "-f", Long: "--format", Description: "With export, json or csv."},
+						{Long: "--table", Description: "With export, only one table, e.g. standings or usage."},
+						{Short: "-c", Long: "--comp", Description: "With watch, competitions to watch: tcg, vgc or plugin IDs."},
+						{Short: "-i", Long: "--interval", Description: "With watch, time between polls. Defaults to 10m."},
+						{Long: "--once", Description: "With watch, poll once and exit."},
+					},
 				},
 			),
 		)
@@ -29,6 +63,14 @@ func CompCommand(args []string) (string, error) {
 		return output.String(), nil
 	}
 
+	if len(args) > 1 && args[1] == "watch" {
+		return watchCommand(args[2:])
+	}
+
+	if len(args) > 2 && args[2] == "export" {
+		return exportCommand(args[1], args[3:])
+	}
+
 	// Validate arguments
 	if err := utils.ValidateArgs(
 		args,
@@ -38,9 +80,16 @@ func CompCommand(args []string) (string, error) {
 		return output.String(), err
 	}
 
+	plugins, err := loadPlugins()
+	if err != nil {
+		err = fmt.Errorf("%s", utils.FormatError(fmt.Sprintf("Could not load comp plugins: %v", err)))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
 	// Program 1: Competition type selection
 	for {
-		finalModel, err := tea.NewProgram(CompList()).Run()
+		finalModel, err := tea.NewProgram(CompList(plugins...)).Run()
 		if err != nil {
 			return "", fmt.Errorf("error running comp selection program: %w", err)
 		}
@@ -63,6 +112,12 @@ func CompCommand(args []string) (string, error) {
 			back, err = vgc.Run()
 		case "champions":
 			back, err = champions.Run()
+		default:
+			for _, p := range plugins {
+				if p.ID == result.compID {
+					back, err = shell.Run(p.Spec, p.Conn)
+				}
+			}
 		}
 		if err != nil {
 			return "", err
@@ -0,0 +1,98 @@
+package comp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/champions"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/tcg"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/vgc"
+	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	flag "github.com/spf13/pflag"
+)
+
+// exportConn is swapped out in tests.
+var exportConn shell.ConnFunc = connections.CallTCGData
+
+// exportCommand handles 'poke-cli comp <tcg | vgc | champions | plugin> export [flags]'
+func exportCommand(compID string, args []string) (string, error) {
+	var output strings.Builder
+
+	fail := func(msg string) (string, error) {
+		err := fmt.Errorf("%s", utils.FormatError(msg))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	ef := flags.SetupCompExportFlagSet()
+	if err := ef.FlagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("comp", err))
+		return output.String(), err
+	}
+
+	if ef.FlagSet.NArg() > 0 {
+		return fail(fmt.Sprintf("Unexpected argument %q\nUse --tournament to pick a tournament, e.g. --tournament=\"Orlando, FL\"", ef.FlagSet.Arg(0)))
+	}
+
+	format := strings.ToLower(*ef.Format)
+	if format != "json" && format != "csv" {
+		return fail("--format must be json or csv.")
+	}
+
+	var (
+		export shell.Export
+		err    error
+	)
+	switch compID {
+	case "tcg":
+		export, err = shell.ExportTournament("tcg", tcg.Spec(), exportConn, *ef.Tournament)
+	case "vgc":
+		export, err = shell.ExportTournament("vgc", vgc.Spec(), exportConn, *ef.Tournament)
+	case "champions":
+		if *ef.Tournament != "" {
+			return fail("--tournament does not apply to champions.\nChampions data covers the current format, not a single tournament.")
+		}
+		export, err = champions.Export(exportConn)
+	default:
+		p, ok, loadErr := findPlugin(compID)
+		if loadErr != nil {
+			return fail(fmt.Sprintf("Could not load comp plugins: %v", loadErr))
+		}
+		if !ok {
+			return fail(fmt.Sprintf("Cannot export %q\nThe only available options are tcg, vgc and champions,\nor the ID of a comp plugin", compID))
+		}
+		export, err = shell.ExportTournament(p.ID, p.Spec, p.Conn, *ef.Tournament)
+	}
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	// CSV holds one table, so it defaults to the first
+	table := *ef.Table
+	if table == "" && format == "csv" && len(export.Tables) > 0 {
+		table = export.Tables[0].Name
+	}
+	if table != "" {
+		if export, err = export.Only(table); err != nil {
+			return fail(err.Error())
+		}
+	}
+
+	if format == "csv" {
+		err = export.Tables[0].WriteCSV(&output)
+	} else {
+		err = export.WriteJSON(&output)
+	}
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
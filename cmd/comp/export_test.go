@@ -0,0 +1,127 @@
+package comp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/plugin"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stubExportConn(t *testing.T) {
+	t.Helper()
+	original := exportConn
+	t.Cleanup(func() { exportConn = original })
+
+	exportConn = func(url string) ([]byte, error) {
+		switch {
+		case strings.Contains(url, "rank=eq.1"):
+			return []byte(`[{"location":"Orlando, FL","text_date":"May 1-3, 2026"},{"location":"Indianapolis","text_date":"Apr 4-6, 2026"}]`), nil
+		case strings.Contains(url, "comp_tcg_standings_view"):
+			return []byte(`[{"rank":1,"name":"Ash","points":47,"record":"15 - 1 - 0","deck":"Gardevoir ex","player_country":"USA","country_code":"US"}]`), nil
+		case strings.Contains(url, "pikalytics_usage"):
+			return []byte(`[{"rank":1,"pokemon":"Basculegion","usage_percent":51.5}]`), nil
+		default:
+			return []byte(`[]`), nil
+		}
+	}
+}
+
+// stubPlugins serves a league plugin from memory instead of the config dir.
+func stubPlugins(t *testing.T) {
+	t.Helper()
+	original := loadPlugins
+	t.Cleanup(func() { loadPlugins = original })
+
+	p, err := plugin.Parse("league", []byte(`name = "Local League"
+list_url = "https://league.example/events"
+dashboard_url = "https://league.example/events/{location}"
+
+[standings]
+entry = "deck"
+entry_title = "Deck"
+`))
+	require.NoError(t, err)
+	p.Conn = func(url string) ([]byte, error) {
+		if strings.HasSuffix(url, "/events") {
+			return []byte(`[{"location":"Pallet Town","text_date":"Oct 4, 2026"}]`), nil
+		}
+		return []byte(`[{"rank":1,"name":"Red","deck":"Charizard ex"},{"rank":2,"name":"Blue","deck":"Dragapult ex"}]`), nil
+	}
+	loadPlugins = func() ([]plugin.Plugin, error) { return []plugin.Plugin{p}, nil }
+}
+
+func TestCompExport_Plugin(t *testing.T) {
+	stubPlugins(t)
+
+	output, err := CompCommand([]string{"comp", "league", "export", "-f", "csv"})
+	require.NoError(t, err, output)
+	assert.Equal(t, "rank,name,deck\n1,Red,Charizard ex\n2,Blue,Dragapult ex", output)
+
+	output, err = CompCommand([]string{"comp", "league", "export", "--table=entries"})
+	require.NoError(t, err, output)
+	assert.Contains(t, output, `"competition": "league"`)
+	assert.Contains(t, output, `"tournament": "Pallet Town"`)
+	assert.Contains(t, output, `{"deck": "Charizard ex", "players": 1}`)
+}
+
+func TestCompExport_JSON(t *testing.T) {
+	stubExportConn(t)
+
+	output, err := CompCommand([]string{"comp", "tcg", "export", "--tournament=orlando, fl"})
+	require.NoError(t, err, output)
+
+	assert.Contains(t, output, `"tournament": "Orlando, FL"`)
+	assert.Contains(t, output, `"standings": [`)
+	assert.Contains(t, output, `"decks": [`)
+	assert.Contains(t, output, `{"deck": "Gardevoir ex", "players": 1}`)
+}
+
+func TestCompExport_CSV(t *testing.T) {
+	stubExportConn(t)
+
+	output, err := CompCommand([]string{"comp", "tcg", "export", "-f", "csv"})
+	require.NoError(t, err, output)
+	assert.Equal(t, "rank,name,points,record,opp_win_percent,opp_opp_win_percent,deck,player_country,country_code\n"+
+		"1,Ash,47,15 - 1 - 0,,,Gardevoir ex,USA,US", output)
+
+	output, err = CompCommand([]string{"comp", "tcg", "export", "-f", "csv", "--table", "countries"})
+	require.NoError(t, err, output)
+	assert.Equal(t, "country,players\nUSA,1", output)
+}
+
+func TestCompExport_Champions(t *testing.T) {
+	stubExportConn(t)
+
+	output, err := CompCommand([]string{"comp", "champions", "export", "--format=csv"})
+	require.NoError(t, err, output)
+	assert.Equal(t, "rank,pokemon,usage_percent\n1,Basculegion,51.5", output)
+}
+
+func TestCompExport_Errors(t *testing.T) {
+	stubExportConn(t)
+	stubPlugins(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"bad format", []string{"comp", "tcg", "export", "--format=xml"}, "--format must be json or csv"},
+		{"unknown tournament", []string{"comp", "vgc", "export", "--tournament=Worlds"}, "Available tournaments: Orlando, FL, Indianapolis"},
+		{"unknown table", []string{"comp", "tcg", "export", "--table=usage"}, "Available tables: standings, decks, countries"},
+		{"champions tournament", []string{"comp", "champions", "export", "-t", "Orlando, FL"}, "--tournament does not apply to champions"},
+		{"unknown competition", []string{"comp", "bogus", "export"}, "only available options are tcg, vgc and champions"},
+		{"stray argument", []string{"comp", "tcg", "export", "Orlando"}, "Unexpected argument"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := CompCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.want)
+		})
+	}
+}
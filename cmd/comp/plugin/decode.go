@@ -0,0 +1,270 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/table"
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+// overviewFinishers is how many top finishers the overview lists.
+const overviewFinishers = 8
+
+// lookup follows a dot separated path, e.g. "player.name" or "rounds.0.result".
+// An empty path is the value itself.
+func lookup(v any, path string) (any, bool) {
+	if path == "" {
+		return v, true
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]any:
+			next, ok := node[key]
+			if !ok {
+				return nil, false
+			}
+			v = next
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			v = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// format prints a JSON value for a table cell. Lists are joined with commas.
+func format(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			if s := format(item); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return ""
+	}
+}
+
+func field(obj map[string]any, path string) string {
+	if path == "" {
+		return ""
+	}
+	v, _ := lookup(obj, path)
+	return format(v)
+}
+
+// rank reads a placing such as 3, "3" or "3rd".
+func rank(obj map[string]any, path string) int {
+	s := field(obj, path)
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	n, _ := strconv.Atoi(s[:end])
+	return n
+}
+
+// snake turns a column title into an export key, e.g. "Win %" to "win".
+func snake(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	return strings.Join(words, "_")
+}
+
+func (f File) spec() shell.Spec {
+	spec := shell.Spec{
+		Tabs:         []string{"Overview", "Standings", f.Standings.EntryTitle, "Countries"},
+		ListURL:      f.ListURL,
+		DecodeList:   f.decodeList,
+		DashboardURL: f.dashboardURL,
+		Columns:      f.columns,
+		Decode:       f.decode,
+	}
+	if f.Standings.Entry != "" {
+		spec.TrendCategories = []string{f.Standings.EntryTitle}
+	}
+	return spec
+}
+
+// columns shares out the spare width between columns without a set width.
+func (f File) columns(width int) []table.Column {
+	fixed, flexible := 0, 0
+	for _, c := range f.Columns {
+		if c.Width > 0 {
+			fixed += c.Width
+		} else {
+			flexible++
+		}
+	}
+	flexWidth := 0
+	if flexible > 0 {
+		flexWidth = min(max((width-fixed-len(f.Columns)*2)/flexible, 8), 30)
+	}
+
+	out := make([]table.Column, len(f.Columns))
+	for i, c := range f.Columns {
+		w := c.Width
+		if w <= 0 {
+			w = flexWidth
+		}
+		out[i] = table.Column{Title: c.Title, Width: w}
+	}
+	return out
+}
+
+func tally(counts map[string]int) []shell.Tally {
+	items := make([]shell.Tally, 0, len(counts))
+	for label, n := range counts {
+		items = append(items, shell.Tally{Label: label, Count: n})
+	}
+	return items
+}
+
+func (f File) decode(body []byte) (shell.Decoded, error) {
+	rows, err := records(body, f.RowsPath)
+	if err != nil {
+		return shell.Decoded{}, err
+	}
+	return f.build(rows), nil
+}
+
+// build lays out the dashboard for a set of standings rows, so a filter can
+// rebuild it from a subset.
+func (f File) build(rows []map[string]any) shell.Decoded {
+	entryKey := snake(f.Standings.EntryTitle)
+	standings := shell.Table{Name: "standings", Columns: []string{"rank", "name"}}
+	if f.Standings.Entry != "" {
+		standings.Columns = append(standings.Columns, entryKey)
+	}
+	if f.Standings.Country != "" {
+		standings.Columns = append(standings.Columns, "country")
+	}
+	// Extra columns follow the mapped fields, skipping any that repeat them
+	var extra []Column
+	for _, c := range f.Columns {
+		key := snake(c.Title)
+		if key == "" || slices.Contains(standings.Columns, key) || c.Path == f.Standings.Rank || c.Path == f.Standings.Name ||
+			c.Path == f.Standings.Entry || c.Path == f.Standings.Country {
+			continue
+		}
+		standings.Columns = append(standings.Columns, key)
+		extra = append(extra, c)
+	}
+
+	d := shell.Decoded{TableRows: make([]table.Row, len(rows))}
+	entries, countries := map[string]int{}, map[string]int{}
+	for i, r := range rows {
+		cells := make(table.Row, len(f.Columns))
+		for j, c := range f.Columns {
+			cells[j] = field(r, c.Path)
+		}
+		d.TableRows[i] = cells
+
+		rk, name := rank(r, f.Standings.Rank), field(r, f.Standings.Name)
+		entry, country := field(r, f.Standings.Entry), field(r, f.Standings.Country)
+		record := []any{rk, name}
+		if f.Standings.Entry != "" {
+			record = append(record, entry)
+		}
+		if f.Standings.Country != "" {
+			record = append(record, country)
+		}
+		for _, c := range extra {
+			record = append(record, field(r, c.Path))
+		}
+		standings.Rows = append(standings.Rows, record)
+
+		if entry != "" {
+			entries[entry]++
+		}
+		if country != "" {
+			countries[country]++
+		}
+		placement := shell.Placement{Rank: rk, Country: country, CountryCode: field(r, f.Standings.CountryCode)}
+		if entry != "" {
+			placement.Labels = map[string][]string{f.Standings.EntryTitle: {entry}}
+		}
+		d.Placements = append(d.Placements, placement)
+	}
+
+	d.Countries = tally(countries)
+	d.Extra = shell.Frequency{
+		NameHeader:  f.Standings.EntryTitle,
+		CountHeader: "Players",
+		Items:       tally(entries),
+	}
+	d.Tables = []shell.Table{standings}
+	if f.Standings.Entry != "" {
+		d.Tables = append(d.Tables, shell.TallyTable("entries", entryKey, "players", d.Extra.Items))
+	}
+	if f.Standings.Country != "" {
+		d.Tables = append(d.Tables, shell.TallyTable("countries", "country", "players", d.Countries))
+	}
+
+	finishers := shell.TopCut(d, overviewFinishers)
+	entryOf := map[string]string{}
+	for _, r := range rows {
+		entryOf[field(r, f.Standings.Name)] = field(r, f.Standings.Entry)
+	}
+	name, players := f.Name, len(rows)
+	d.Overview = func(contentWidth int, hc color.Color) string {
+		return overview(name, players, finishers, entryOf, contentWidth, hc)
+	}
+	d.Filter = func(keep func(int) bool) shell.Decoded {
+		var kept []map[string]any
+		for i, r := range rows {
+			if keep(i) {
+				kept = append(kept, r)
+			}
+		}
+		return f.build(kept)
+	}
+	return d
+}
+
+func overview(name string, players int, finishers []shell.Finisher, entryOf map[string]string, contentWidth int, hc color.Color) string {
+	if len(finishers) == 0 {
+		return "No standings for this tournament."
+	}
+
+	highlight := lipgloss.NewStyle().Foreground(hc).Bold(true)
+	var b strings.Builder
+	b.WriteString(highlight.Render(name))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%s players\n\n", shell.FormatInt(players)))
+	b.WriteString(styling.StyleBold.Render("Top finishers"))
+	b.WriteString("\n")
+	for _, f := range finishers {
+		line := fmt.Sprintf("#%-3d %s", f.Rank, f.Name)
+		if e := entryOf[f.Name]; e != "" {
+			line += "  ·  " + e
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return lipgloss.NewStyle().Width(contentWidth).Render(b.String())
+}
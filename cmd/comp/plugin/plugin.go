@@ -0,0 +1,287 @@
+// Package plugin builds comp dashboards from TOML files in the config dir, so
+// other standings sources, like a local league, can be added without code changes.
+//
+// Each <config dir>/poke-cli/comp/<id>.toml file describes one source:
+//
+//	name = "Kanto League"
+//	list_url = "https://league.example/api/events"
+//	list_path = "data"
+//	dashboard_url = "https://league.example/api/events/{location}/standings"
+//	rows_path = "standings"
+//
+//	[headers]
+//	Authorization = "Bearer ${KANTO_TOKEN}"
+//
+//	[tournament]
+//	location = "slug"
+//	date = "date"
+//
+//	[standings]
+//	rank = "placing"
+//	name = "player.name"
+//	country = "player.country"
+//	country_code = "player.country_code"
+//	entry = "deck.name"
+//	entry_title = "Deck"
+//
+//	[[columns]]
+//	title = "Record"
+//	path = "record"
+//	width = 10
+//
+// Paths are dot separated keys into the JSON response, with numbers for array
+// indexes. Without columns, the standings show rank, name, entry and country.
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	toml "github.com/pelletier/go-toml/v2"
+)
+
+// locationPlaceholder marks where the tournament location goes in dashboard_url.
+const locationPlaceholder = "{location}"
+
+// reserved IDs are taken by built-in competitions and subcommands.
+var reserved = map[string]bool{"tcg": true, "vgc": true, "champions": true, "watch": true}
+
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// File is the TOML layout of a plugin.
+type File struct {
+	Name         string            `toml:"name"`
+	ListURL      string            `toml:"list_url"`
+	ListPath     string            `toml:"list_path"`
+	DashboardURL string            `toml:"dashboard_url"`
+	RowsPath     string            `toml:"rows_path"`
+	Headers      map[string]string `toml:"headers"`
+	Tournament   TournamentFields  `toml:"tournament"`
+	Standings    StandingsFields   `toml:"standings"`
+	Columns      []Column          `toml:"columns"`
+}
+
+// TournamentFields are the paths to each tournament's fields in the list.
+type TournamentFields struct {
+	Location string `toml:"location"`
+	Date     string `toml:"date"`
+}
+
+// StandingsFields are the paths to each player's fields in the standings.
+// Country, CountryCode and Entry are optional. CountryCode is an ISO 3166 code,
+// used to group countries into regions.
+type StandingsFields struct {
+	Rank        string `toml:"rank"`
+	Name        string `toml:"name"`
+	Country     string `toml:"country"`
+	CountryCode string `toml:"country_code"`
+	Entry       string `toml:"entry"`
+	EntryTitle  string `toml:"entry_title"`
+}
+
+// Column is one standings column.
+type Column struct {
+	Title string `toml:"title"`
+	Path  string `toml:"path"`
+	Width int    `toml:"width"`
+}
+
+// Plugin is a loaded competition, ready for the comp dashboard.
+type Plugin struct {
+	// ID is the file name without .toml, as in 'comp <id> export'.
+	ID   string
+	Name string
+	Spec shell.Spec
+	Conn shell.ConnFunc
+}
+
+// Dir is where plugin files live, next to the config file.
+func Dir() (string, error) {
+	return flags.StatePath("comp")
+}
+
+// Load reads every .toml file in dir, sorted by ID. A missing dir means there
+// are no plugins.
+func Load(dir string) ([]Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var plugins []Plugin
+	names := map[string]string{}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".toml" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path) // #nosec G304
+		if err != nil {
+			return nil, err
+		}
+		p, err := Parse(strings.TrimSuffix(e.Name(), ".toml"), data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if other, ok := names[strings.ToLower(p.Name)]; ok {
+			return nil, fmt.Errorf("%s: name %q is already used by %s", path, p.Name, other)
+		}
+		names[strings.ToLower(p.Name)] = e.Name()
+		plugins = append(plugins, p)
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].ID < plugins[j].ID })
+	return plugins, nil
+}
+
+// Parse validates a plugin file and builds its dashboard spec.
+func Parse(id string, data []byte) (Plugin, error) {
+	var f File
+	if err := toml.Unmarshal(data, &f); err != nil {
+		return Plugin{}, err
+	}
+	if err := f.validate(id); err != nil {
+		return Plugin{}, err
+	}
+	f.setDefaults()
+
+	headers := make(map[string]string, len(f.Headers))
+	for k, v := range f.Headers {
+		headers[k] = os.ExpandEnv(v)
+	}
+
+	return Plugin{
+		ID:   id,
+		Name: f.Name,
+		Spec: f.spec(),
+		Conn: func(url string) ([]byte, error) { return connections.CallWithHeaders(url, headers) },
+	}, nil
+}
+
+func (f File) validate(id string) error {
+	switch {
+	case !validID.MatchString(id):
+		return fmt.Errorf("file name must be lowercase letters, numbers, - or _")
+	case reserved[id]:
+		return fmt.Errorf("%q is a built-in competition", id)
+	case strings.TrimSpace(f.Name) == "":
+		return errors.New("name is required")
+	case !strings.Contains(f.DashboardURL, locationPlaceholder):
+		return fmt.Errorf("dashboard_url must contain %s", locationPlaceholder)
+	}
+	for key, raw := range map[string]string{"list_url": f.ListURL, "dashboard_url": f.DashboardURL} {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("%s must be an http or https URL", key)
+		}
+	}
+	for i, c := range f.Columns {
+		if c.Title == "" || c.Path == "" {
+			return fmt.Errorf("column %d needs a title and a path", i+1)
+		}
+	}
+	return nil
+}
+
+// setDefaults fills in the field names used by the built-in competitions.
+func (f *File) setDefaults() {
+	def := func(s *string, v string) {
+		if *s == "" {
+			*s = v
+		}
+	}
+	def(&f.Tournament.Location, "location")
+	def(&f.Tournament.Date, "text_date")
+	def(&f.Standings.Rank, "rank")
+	def(&f.Standings.Name, "name")
+	def(&f.Standings.EntryTitle, "Entry")
+
+	if len(f.Columns) > 0 {
+		return
+	}
+	f.Columns = []Column{
+		{Title: "Rank", Path: f.Standings.Rank, Width: 6},
+		{Title: "Name", Path: f.Standings.Name, Width: 24},
+	}
+	if f.Standings.Entry != "" {
+		f.Columns = append(f.Columns, Column{Title: f.Standings.EntryTitle, Path: f.Standings.Entry, Width: 30})
+	}
+	if f.Standings.Country != "" {
+		f.Columns = append(f.Columns, Column{Title: "Country", Path: f.Standings.Country, Width: 18})
+	}
+}
+
+// dashboardURL fills in the location, escaped for the query string or the
+// path depending on where the placeholder sits.
+func (f File) dashboardURL(location string) string {
+	escaped := url.PathEscape(location)
+	if q := strings.IndexByte(f.DashboardURL, '?'); q >= 0 && q < strings.Index(f.DashboardURL, locationPlaceholder) {
+		escaped = url.QueryEscape(location)
+	}
+	return strings.ReplaceAll(f.DashboardURL, locationPlaceholder, escaped)
+}
+
+// decodeJSON keeps numbers as json.Number so ranks and points print as written.
+func decodeJSON(body []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// records finds the array of objects at path.
+func records(body []byte, path string) ([]map[string]any, error) {
+	root, err := decodeJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := lookup(root, path)
+	if !ok {
+		return nil, fmt.Errorf("no value at %q", path)
+	}
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list at %q", path)
+	}
+
+	out := make([]map[string]any, 0, len(list))
+	for _, item := range list {
+		if obj, ok := item.(map[string]any); ok {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+func (f File) decodeList(body []byte) ([]shell.TournamentRef, error) {
+	items, err := records(body, f.ListPath)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]shell.TournamentRef, 0, len(items))
+	for _, item := range items {
+		location := field(item, f.Tournament.Location)
+		if location == "" {
+			continue
+		}
+		refs = append(refs, shell.TournamentRef{Location: location, TextDate: field(item, f.Tournament.Date)})
+	}
+	return refs, nil
+}
@@ -0,0 +1,238 @@
+package plugin
+
+import (
+	"image/color"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const kantoPlugin = `
+name = "Kanto League"
+list_url = "https://league.example/api/events"
+list_path = "data"
+dashboard_url = "https://league.example/api/events/{location}/standings"
+rows_path = "standings"
+
+[headers]
+Authorization = "Bearer ${KANTO_TOKEN}"
+
+[tournament]
+location = "slug"
+date = "date"
+
+[standings]
+rank = "placing"
+name = "player.name"
+country = "player.country"
+country_code = "player.code"
+entry = "deck.name"
+entry_title = "Deck"
+
+[[columns]]
+title = "Place"
+path = "placing"
+width = 6
+
+[[columns]]
+title = "Player"
+path = "player.name"
+
+[[columns]]
+title = "Deck"
+path = "deck.name"
+
+[[columns]]
+title = "Win %"
+path = "stats.win_rate"
+width = 8
+`
+
+const kantoStandings = `{"standings": [
+	{"placing": "1st", "player": {"name": "Red", "country": "Japan"}, "deck": {"name": "Charizard ex"}, "stats": {"win_rate": 0.875}},
+	{"placing": 2, "player": {"name": "Blue", "country": "Japan", "code": "JP"}, "deck": {"name": "Dragapult ex"}, "stats": {"win_rate": 0.75}},
+	{"placing": 3, "player": {"name": "Leaf", "country": "USA"}, "deck": {"name": "Charizard ex"}}
+]}`
+
+func TestParse(t *testing.T) {
+	t.Setenv("KANTO_TOKEN", "pallet")
+
+	p, err := Parse("kanto", []byte(kantoPlugin))
+	require.NoError(t, err)
+	assert.Equal(t, "kanto", p.ID)
+	assert.Equal(t, "Kanto League", p.Name)
+	assert.Equal(t, []string{"Overview", "Standings", "Deck", "Countries"}, p.Spec.Tabs)
+	assert.Equal(t, []string{"Deck"}, p.Spec.TrendCategories)
+	assert.Equal(t, "https://league.example/api/events/Pallet%20Town/standings", p.Spec.DashboardURL("Pallet Town"))
+
+	cols := p.Spec.Columns(80)
+	require.Len(t, cols, 4)
+	assert.Equal(t, 6, cols[0].Width)
+	assert.Equal(t, cols[1].Width, cols[2].Width, "columns without a width share the rest")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer pallet", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer srv.Close()
+	_, err = p.Conn(srv.URL)
+	require.NoError(t, err)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	valid := func(extra string) string {
+		return `name = "Kanto League"
+list_url = "https://league.example/events"
+dashboard_url = "https://league.example/events/{location}"
+` + extra
+	}
+
+	tests := []struct {
+		name, id, data, want string
+	}{
+		{"bad toml", "kanto", "name = ", "toml"},
+		{"bad id", "Kanto League", valid(""), "file name"},
+		{"reserved id", "vgc", valid(""), "built-in"},
+		{"no name", "kanto", `list_url = "https://a.example"` + "\n" + `dashboard_url = "https://a.example/{location}"`, "name is required"},
+		{"no placeholder", "kanto", `name = "K"` + "\n" + `list_url = "https://a.example"` + "\n" + `dashboard_url = "https://a.example/"`, "{location}"},
+		{"bad scheme", "kanto", `name = "K"` + "\n" + `list_url = "file:///etc/passwd"` + "\n" + `dashboard_url = "https://a.example/{location}"`, "list_url"},
+		{"column without path", "kanto", valid("[[columns]]\ntitle = \"Rank\"\n"), "column 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.id, []byte(tt.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestParse_Defaults(t *testing.T) {
+	p, err := Parse("league", []byte(`name = "League"
+list_url = "https://league.example/events"
+dashboard_url = "https://league.example/standings?event={location}"
+`))
+	require.NoError(t, err)
+
+	assert.Nil(t, p.Spec.TrendCategories, "no entry, no trends")
+	assert.Equal(t, "https://league.example/standings?event=Pallet+Town", p.Spec.DashboardURL("Pallet Town"))
+
+	cols := p.Spec.Columns(80)
+	require.Len(t, cols, 2)
+	assert.Equal(t, "Rank", cols[0].Title)
+	assert.Equal(t, "Name", cols[1].Title)
+
+	refs, err := p.Spec.DecodeList([]byte(`[{"location": "Pallet Town", "text_date": "Oct 1, 2026"}, {"text_date": "no location"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []shell.TournamentRef{{Location: "Pallet Town", TextDate: "Oct 1, 2026"}}, refs)
+}
+
+func TestDecode(t *testing.T) {
+	p, err := Parse("kanto", []byte(kantoPlugin))
+	require.NoError(t, err)
+
+	d, err := p.Spec.Decode([]byte(kantoStandings))
+	require.NoError(t, err)
+
+	require.Len(t, d.TableRows, 3)
+	assert.Equal(t, []string{"1st", "Red", "Charizard ex", "0.875"}, []string(d.TableRows[0]))
+	assert.Equal(t, "", d.TableRows[2][3], "missing values are blank")
+
+	assert.ElementsMatch(t, []shell.Tally{{Label: "Japan", Count: 2}, {Label: "USA", Count: 1}}, d.Countries)
+	assert.Equal(t, "Deck", d.Extra.NameHeader)
+	assert.ElementsMatch(t, []shell.Tally{{Label: "Charizard ex", Count: 2}, {Label: "Dragapult ex", Count: 1}}, d.Extra.Items)
+
+	require.Len(t, d.Tables, 3)
+	standings := d.Tables[0]
+	assert.Equal(t, []string{"rank", "name", "deck", "country", "win"}, standings.Columns)
+	assert.Equal(t, []any{1, "Red", "Charizard ex", "Japan", "0.875"}, standings.Rows[0])
+	assert.Equal(t, "entries", d.Tables[1].Name)
+	assert.Equal(t, []string{"deck", "players"}, d.Tables[1].Columns)
+	assert.Equal(t, "countries", d.Tables[2].Name)
+
+	assert.Equal(t, []shell.Finisher{{Rank: 1, Name: "Red"}, {Rank: 2, Name: "Blue"}}, shell.TopCut(d, 2))
+	assert.Equal(t, map[string][]string{"Deck": {"Dragapult ex"}}, d.Placements[1].Labels)
+
+	assert.Equal(t, "JP", d.Placements[1].CountryCode)
+	usa := d.Filter(func(i int) bool { return d.Placements[i].Country == "USA" })
+	assert.Equal(t, [][]any{{3, "Leaf", "Charizard ex", "USA", ""}}, usa.Tables[0].Rows)
+
+	overview := styling.StripANSI(d.Overview(60, color.White))
+	assert.Contains(t, overview, "Kanto League")
+	assert.Contains(t, overview, "3 players")
+	assert.Contains(t, overview, "#1   Red  ·  Charizard ex")
+}
+
+func TestDecode_BadPath(t *testing.T) {
+	p, err := Parse("kanto", []byte(kantoPlugin))
+	require.NoError(t, err)
+
+	_, err = p.Spec.Decode([]byte(`{"results": []}`))
+	assert.ErrorContains(t, err, `no value at "standings"`)
+
+	_, err = p.Spec.Decode([]byte(`{"standings": {"rank": 1}}`))
+	assert.ErrorContains(t, err, `expected a list at "standings"`)
+}
+
+func TestLookup(t *testing.T) {
+	root, err := decodeJSON([]byte(`{"a": {"b": [{"c": 7}, {"c": "x"}]}, "tags": ["one", 2, null]}`))
+	require.NoError(t, err)
+
+	v, ok := lookup(root, "a.b.1.c")
+	assert.True(t, ok)
+	assert.Equal(t, "x", v)
+
+	v, ok = lookup(root, "a.b.0.c")
+	assert.True(t, ok)
+	assert.Equal(t, "7", format(v))
+
+	for _, path := range []string{"a.missing", "a.b.9.c", "a.b.c", "tags.0.x"} {
+		_, ok := lookup(root, path)
+		assert.False(t, ok, path)
+	}
+
+	obj := root.(map[string]any)
+	assert.Equal(t, "one, 2", field(obj, "tags"))
+	assert.Equal(t, "", field(obj, ""))
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600))
+	}
+
+	plugins, err := Load(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+
+	write("kanto.toml", kantoPlugin)
+	write("johto.toml", `name = "Johto Cup"
+list_url = "https://johto.example/events"
+dashboard_url = "https://johto.example/events/{location}"
+`)
+	write("notes.txt", "not a plugin")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "old.toml"), 0o750))
+
+	plugins, err = Load(dir)
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	assert.Equal(t, "johto", plugins[0].ID)
+	assert.Equal(t, "kanto", plugins[1].ID)
+
+	write("kanto2.toml", kantoPlugin)
+	_, err = Load(dir)
+	assert.ErrorContains(t, err, `name "Kanto League" is already used by kanto.toml`)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "kanto2.toml")))
+	write("broken.toml", `name = "Broken"`)
+	_, err = Load(dir)
+	assert.ErrorContains(t, err, "broken.toml: dashboard_url")
+}
@@ -3,6 +3,7 @@ package comp
 import (
 	"charm.land/bubbles/v2/list"
 	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/plugin"
 	"github.com/digitalghost-dev/poke-cli/styling"
 )
 
@@ -13,7 +14,9 @@ var compIDMap = map[string]string{
 }
 
 type pickerModel struct {
-	list     list.Model
+	list list.Model
+	// ids maps item labels to competition IDs, plugins included.
+	ids      map[string]string
 	choice   string
 	compID   string
 	quitting bool
@@ -34,7 +37,7 @@ func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			i, ok := m.list.SelectedItem().(styling.Item)
 			if ok {
 				m.choice = string(i)
-				m.compID = compIDMap[string(i)]
+				m.compID = m.ids[string(i)]
 			}
 			return m, tea.Quit
 		}
@@ -63,15 +66,29 @@ func (m pickerModel) View() tea.View {
 	return v
 }
 
-func CompList() pickerModel {
+// CompList builds the competition picker, with any plugins after the built-in
+// competitions.
+func CompList(plugins ...plugin.Plugin) pickerModel {
 	items := []list.Item{
 		styling.Item("TCG Competition Data"),
 		styling.Item("VGC Competition Data"),
 		styling.Item("Pokémon Champions Data"),
 	}
+	ids := make(map[string]string, len(compIDMap)+len(plugins))
+	for label, id := range compIDMap {
+		ids[label] = id
+	}
+	for _, p := range plugins {
+		// Built-in labels win over a plugin with the same name
+		if _, taken := ids[p.Name]; taken {
+			continue
+		}
+		items = append(items, styling.Item(p.Name))
+		ids[p.Name] = p.ID
+	}
 
 	const listWidth = 24
-	const listHeight = 12
+	listHeight := 12 + len(plugins)
 
 	l := list.New(items, styling.ItemDelegate{}, listWidth, listHeight)
 	l.Title = "Pick a competition type"
@@ -81,5 +98,5 @@ func CompList() pickerModel {
 	l.Styles.PaginationStyle = styling.PaginationStyle
 	l.Styles.HelpStyle = styling.HelpStyle
 
-	return pickerModel{list: l}
+	return pickerModel{list: l, ids: ids}
 }
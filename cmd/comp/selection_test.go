@@ -7,6 +7,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/plugin"
 )
 
 func TestCompList_BuildsModel(t *testing.T) {
@@ -22,6 +23,22 @@ func TestCompList_BuildsModel(t *testing.T) {
 	}
 }
 
+func TestCompList_Plugins(t *testing.T) {
+	m := CompList(
+		plugin.Plugin{ID: "league", Name: "Local League"},
+		plugin.Plugin{ID: "clash", Name: "TCG Competition Data"},
+	)
+	if len(m.list.Items()) != 4 {
+		t.Fatalf("expected 4 items, a plugin may not replace a built-in, got %d", len(m.list.Items()))
+	}
+	if got := m.ids["Local League"]; got != "league" {
+		t.Errorf("ids[%q] = %q, want %q", "Local League", got, "league")
+	}
+	if got := m.ids["TCG Competition Data"]; got != "tcg" {
+		t.Errorf("ids[%q] = %q, want %q", "TCG Competition Data", got, "tcg")
+	}
+}
+
 func TestCompIDMap(t *testing.T) {
 	tests := map[string]string{
 		"TCG Competition Data":   "tcg",
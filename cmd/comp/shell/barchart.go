@@ -12,23 +12,35 @@ type Tally struct {
 }
 
 func BarChart(s []Tally, width, labelWidth int) string {
+	return barChart(s, width, labelWidth, true)
+}
+
+// TopBarChart draws the ten largest values without folding the rest into an
+// "Other" bar, for values that don't add up, like percentage changes or ratios.
+func TopBarChart(s []Tally, width, labelWidth int) string {
+	return barChart(s, width, labelWidth, false)
+}
+
+func barChart(s []Tally, width, labelWidth int, other bool) string {
 	if len(s) == 0 {
 		return ""
 	}
 
 	sorted := make([]Tally, len(s))
 	copy(sorted, s)
-	sort.Slice(sorted, func(i, j int) bool {
+	sort.SliceStable(sorted, func(i, j int) bool {
 		return sorted[i].Count > sorted[j].Count
 	})
 
 	display := sorted
-	if len(sorted) > 9 {
-		other := 0
+	if other && len(sorted) > 9 {
+		rest := 0
 		for _, stat := range sorted[9:] {
-			other += stat.Count
+			rest += stat.Count
 		}
-		display = append(sorted[:9], Tally{Label: "Other", Count: other})
+		display = append(sorted[:9], Tally{Label: "Other", Count: rest})
+	} else if !other && len(sorted) > 10 {
+		display = sorted[:10]
 	}
 
 	const countWidth = 5
@@ -1,6 +1,7 @@
 package shell
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -164,3 +165,20 @@ func TestBarChart_MinOneBlock(t *testing.T) {
 		t.Error("expected at least one block for non-zero small total")
 	}
 }
+
+func TestTopBarChart_NoOtherBar(t *testing.T) {
+	var items []Tally
+	for i := range 12 {
+		items = append(items, Tally{Label: fmt.Sprintf("deck-%02d", i), Count: 100 + i})
+	}
+	result := TopBarChart(items, 80, 20)
+	if strings.Contains(result, "Other") {
+		t.Error("expected no Other bar")
+	}
+	if lines := strings.Count(result, "\n"); lines != 10 {
+		t.Errorf("expected 10 bars, got %d", lines)
+	}
+	if !strings.Contains(result, "deck-11") || strings.Contains(result, "deck-01") {
+		t.Error("expected only the largest values")
+	}
+}
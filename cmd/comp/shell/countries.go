@@ -0,0 +1,225 @@
+package shell
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/table"
+	"charm.land/lipgloss/v2"
+)
+
+// regionOther holds countries without a known region code.
+const regionOther = "Other"
+
+// Regions are the Play! Pokémon rating regions, in the order the region filter
+// cycles through them.
+var Regions = []string{"NA", "LATAM", "EU", "MEA", "APAC", "OCE", regionOther}
+
+var regionCodes = map[string]string{
+	"US": "NA", "CA": "NA",
+
+	"MX": "LATAM", "GT": "LATAM", "HN": "LATAM", "SV": "LATAM", "NI": "LATAM", "CR": "LATAM", "PA": "LATAM",
+	"CU": "LATAM", "DO": "LATAM", "PR": "LATAM", "CO": "LATAM", "VE": "LATAM", "EC": "LATAM", "PE": "LATAM",
+	"BO": "LATAM", "BR": "LATAM", "PY": "LATAM", "UY": "LATAM", "AR": "LATAM", "CL": "LATAM",
+
+	"GB": "EU", "IE": "EU", "FR": "EU", "BE": "EU", "NL": "EU", "LU": "EU", "DE": "EU", "AT": "EU", "CH": "EU",
+	"IT": "EU", "ES": "EU", "PT": "EU", "DK": "EU", "NO": "EU", "SE": "EU", "FI": "EU", "IS": "EU", "PL": "EU",
+	"CZ": "EU", "SK": "EU", "HU": "EU", "SI": "EU", "HR": "EU", "RS": "EU", "BA": "EU", "RO": "EU", "BG": "EU",
+	"GR": "EU", "EE": "EU", "LV": "EU", "LT": "EU", "UA": "EU", "MT": "EU", "CY": "EU",
+
+	"TR": "MEA", "IL": "MEA", "SA": "MEA", "AE": "MEA", "QA": "MEA", "KW": "MEA", "BH": "MEA", "OM": "MEA",
+	"JO": "MEA", "LB": "MEA", "EG": "MEA", "MA": "MEA", "ZA": "MEA",
+
+	"JP": "APAC", "KR": "APAC", "CN": "APAC", "TW": "APAC", "HK": "APAC", "SG": "APAC", "MY": "APAC",
+	"TH": "APAC", "PH": "APAC", "ID": "APAC", "VN": "APAC", "IN": "APAC",
+
+	"AU": "OCE", "NZ": "OCE",
+}
+
+// Region is the rating region of an ISO 3166 country code, e.g. "EU" for "DE".
+func Region(code string) string {
+	if r, ok := regionCodes[strings.ToUpper(code)]; ok {
+		return r
+	}
+	return regionOther
+}
+
+// CountryStat is how one country's players did in a tournament.
+type CountryStat struct {
+	Country string
+	Region  string
+	Players int
+	// AvgPlace is the mean rank of the country's players.
+	AvgPlace float64
+	// TopCut is how many of them finished in the top cut.
+	TopCut int
+	// Top are the country's most played labels in the first trend category.
+	Top []string
+}
+
+// countryStats groups placements by country, largest first. Players without a
+// country are left out.
+func countryStats(placements []Placement, category string) []CountryStat {
+	byCountry := map[string]*CountryStat{}
+	ranks := map[string]int{}
+	labels := map[string]map[string]int{}
+	for _, p := range placements {
+		if p.Country == "" {
+			continue
+		}
+		s, ok := byCountry[p.Country]
+		if !ok {
+			s = &CountryStat{Country: p.Country, Region: Region(p.CountryCode)}
+			byCountry[p.Country] = s
+			labels[p.Country] = map[string]int{}
+		}
+		s.Players++
+		ranks[p.Country] += p.Rank
+		if p.Rank <= topCut {
+			s.TopCut++
+		}
+		for _, label := range p.Labels[category] {
+			if label != "" {
+				labels[p.Country][label]++
+			}
+		}
+	}
+
+	stats := make([]CountryStat, 0, len(byCountry))
+	for country, s := range byCountry {
+		s.AvgPlace = float64(ranks[country]) / float64(s.Players)
+		s.Top = topLabels(labels[country], 2)
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Players != stats[j].Players {
+			return stats[i].Players > stats[j].Players
+		}
+		return stats[i].Country < stats[j].Country
+	})
+	return stats
+}
+
+// topLabels returns the n most counted labels, ties broken by name.
+func topLabels(counts map[string]int, n int) []string {
+	labels := make([]string, 0, len(counts))
+	for label := range counts {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if counts[labels[i]] != counts[labels[j]] {
+			return counts[labels[i]] > counts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+	return labels[:min(len(labels), n)]
+}
+
+// inRegion reports whether the player is from region.
+func (p Placement) inRegion(region string) bool {
+	return p.Country != "" && Region(p.CountryCode) == region
+}
+
+// cycleRegion is the region after current among those with players, going
+// back to all regions, "", after the last.
+func cycleRegion(placements []Placement, current string) string {
+	options := []string{""}
+	for _, t := range regionTallies(placements) {
+		options = append(options, t.Label)
+	}
+	next := options[0]
+	for i, r := range options {
+		if r == current {
+			next = options[(i+1)%len(options)]
+		}
+	}
+	return next
+}
+
+// samplesInRegion keeps the placements of players from region in each
+// tournament, or all of them when region is empty. Ranks stay those of the
+// whole field.
+func samplesInRegion(samples []trendSample, region string) []trendSample {
+	if region == "" {
+		return samples
+	}
+	out := make([]trendSample, len(samples))
+	for i, s := range samples {
+		out[i] = trendSample{ref: s.ref}
+		for _, p := range s.placements {
+			if p.inRegion(region) {
+				out[i].placements = append(out[i].placements, p)
+			}
+		}
+	}
+	return out
+}
+
+// regionTallies counts players per region, in Regions order.
+func regionTallies(placements []Placement) []Tally {
+	counts := map[string]int{}
+	for _, p := range placements {
+		if p.Country != "" {
+			counts[Region(p.CountryCode)]++
+		}
+	}
+	var out []Tally
+	for _, r := range Regions {
+		if counts[r] > 0 {
+			out = append(out, Tally{Label: r, Count: counts[r]})
+		}
+	}
+	return out
+}
+
+func newCountryTable(stats []CountryStat, width, height int) table.Model {
+	const regionW, playersW, avgW, cutW = 7, 8, 10, 9
+	avail := width - 8
+	countryW := min(max(avail/4, 14), 24)
+	topW := min(max(avail-countryW-regionW-playersW-avgW-cutW-6*2, 16), 40)
+
+	columns := []table.Column{
+		{Title: "Country", Width: countryW},
+		{Title: "Region", Width: regionW},
+		{Title: "Players", Width: playersW},
+		{Title: "Avg Place", Width: avgW},
+		{Title: "Top Cut", Width: cutW},
+		{Title: "Most Played", Width: topW},
+	}
+
+	rows := make([]table.Row, len(stats))
+	for i, s := range stats {
+		rows[i] = table.Row{
+			s.Country,
+			s.Region,
+			strconv.Itoa(s.Players),
+			fmt.Sprintf("%.1f", s.AvgPlace),
+			fmt.Sprintf("%d%%", s.TopCut*100/s.Players),
+			strings.Join(s.Top, ", "),
+		}
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		// Leave room for the region chart above the table
+		table.WithHeight(max(height-14-len(Regions)-3, 5)),
+		table.WithWidth(countryW+regionW+playersW+avgW+cutW+topW+6*2),
+	)
+	t.SetStyles(TableStyles())
+	return t
+}
+
+// renderCountries shows players per region above the per-country table.
+func renderCountries(countries table.Model, regions []Tally, region string, contentWidth int) string {
+	filter := "All regions"
+	if region != "" {
+		filter = "Region: " + region
+	}
+	caption := captionStyle.Width(contentWidth).Render(fmt.Sprintf("%s. Top cut is the top %d; Most Played is the top entries among the country's players.", filter, topCut))
+	chart := strings.TrimSuffix(BarChart(regions, min(contentWidth, 60), 6), "\n")
+	return lipgloss.JoinVertical(lipgloss.Left, chart, "", countries.View(), "", caption)
+}
@@ -0,0 +1,215 @@
+package shell
+
+import (
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/table"
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+func countryPlacements() []Placement {
+	deck := func(d string) map[string][]string { return map[string][]string{"Decks": {d}} }
+	return []Placement{
+		{Rank: 1, Country: "Japan", CountryCode: "JP", Labels: deck("Charizard ex")},
+		{Rank: 2, Country: "USA", CountryCode: "us", Labels: deck("Gardevoir ex")},
+		{Rank: 3, Country: "Japan", CountryCode: "JP", Labels: deck("Gardevoir ex")},
+		{Rank: 40, Country: "Japan", CountryCode: "JP", Labels: deck("Charizard ex")},
+		{Rank: 41, Country: "Germany", CountryCode: "DE", Labels: deck("Dragapult ex")},
+		{Rank: 42, Country: "Atlantis", Labels: deck("Dragapult ex")},
+		{Rank: 43, Labels: deck("Dragapult ex")},
+	}
+}
+
+func TestRegion(t *testing.T) {
+	tests := map[string]string{"US": "NA", "br": "LATAM", "DE": "EU", "ZA": "MEA", "JP": "APAC", "NZ": "OCE", "": "Other", "XX": "Other"}
+	for code, want := range tests {
+		if got := Region(code); got != want {
+			t.Errorf("Region(%q) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestCountryStats(t *testing.T) {
+	stats := countryStats(countryPlacements(), "Decks")
+	if len(stats) != 4 {
+		t.Fatalf("expected 4 countries, players without one are left out, got %d", len(stats))
+	}
+
+	japan := stats[0]
+	if japan.Country != "Japan" || japan.Region != "APAC" || japan.Players != 3 {
+		t.Errorf("unexpected first country: %+v", japan)
+	}
+	if want := (1.0 + 3 + 40) / 3; japan.AvgPlace != want {
+		t.Errorf("AvgPlace = %v, want %v", japan.AvgPlace, want)
+	}
+	if japan.TopCut != 2 {
+		t.Errorf("TopCut = %d, want 2", japan.TopCut)
+	}
+	if want := []string{"Charizard ex", "Gardevoir ex"}; !reflect.DeepEqual(japan.Top, want) {
+		t.Errorf("Top = %v, want %v", japan.Top, want)
+	}
+
+	// Ties on players are sorted by name
+	var names []string
+	for _, s := range stats[1:] {
+		names = append(names, s.Country)
+	}
+	if want := []string{"Atlantis", "Germany", "USA"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("countries = %v, want %v", names, want)
+	}
+	if stats[1].Region != "Other" {
+		t.Errorf("expected a country without a code in Other, got %q", stats[1].Region)
+	}
+}
+
+func TestRegionTallies(t *testing.T) {
+	want := []Tally{{"NA", 1}, {"EU", 1}, {"APAC", 3}, {"Other", 1}}
+	if got := regionTallies(countryPlacements()); !reflect.DeepEqual(got, want) {
+		t.Errorf("regionTallies = %v, want %v", got, want)
+	}
+}
+
+// filterableDecoded builds dashboard data that filters like the competitions do.
+func filterableDecoded(placements []Placement) Decoded {
+	d := testDecoded()
+	d.TableRows = make([]table.Row, len(placements))
+	for i, p := range placements {
+		d.TableRows[i] = table.Row{FormatInt(p.Rank), p.Country}
+	}
+	d.Placements = placements
+	d.Filter = func(keep func(int) bool) Decoded {
+		var kept []Placement
+		for i, p := range placements {
+			if keep(i) {
+				kept = append(kept, p)
+			}
+		}
+		f := filterableDecoded(kept)
+		f.Overview = func(_ int, _ color.Color) string { return "REGION-OVERVIEW" }
+		return f
+	}
+	return d
+}
+
+func TestDashboard_RegionFilter(t *testing.T) {
+	m := newTestDashboard()
+	m.spec.TrendCategories = []string{"Decks"}
+	nm, _ := m.Update(dataMsg{decoded: filterableDecoded(countryPlacements())})
+	m = nm.(dashboardModel)
+	m.activeTab = 3
+
+	content := styling.StripANSI(m.View().Content)
+	for _, want := range []string{"All regions", "Avg Place", "Charizard ex, Gardevoir ex", "r (region: all)"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected countries tab to contain %q", want)
+		}
+	}
+
+	press := func(m dashboardModel) dashboardModel {
+		nm, _ := m.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+		return nm.(dashboardModel)
+	}
+	for _, want := range []struct {
+		region string
+		rows   int
+	}{{"NA", 1}, {"EU", 1}, {"APAC", 3}, {"Other", 1}, {"", 7}} {
+		m = press(m)
+		if m.region != want.region {
+			t.Fatalf("region = %q, want %q", m.region, want.region)
+		}
+		if got := len(m.table.Rows()); got != want.rows {
+			t.Errorf("region %q: %d standings rows, want %d", want.region, got, want.rows)
+		}
+	}
+
+	m = press(press(press(m)))
+	if len(m.source.Placements) != 7 {
+		t.Error("expected the source data to stay whole")
+	}
+	content = styling.StripANSI(m.View().Content)
+	if !strings.Contains(content, "Region: APAC") || strings.Contains(content, "Germany") {
+		t.Errorf("expected only APAC countries, got:\n%s", content)
+	}
+	m.activeTab = 1
+	if !strings.Contains(styling.StripANSI(m.View().Content), "r (region: APAC)") {
+		t.Error("expected the region filter in the standings key menu")
+	}
+}
+
+func TestDashboard_RegionFilter_Unsupported(t *testing.T) {
+	m := loadedTestDashboard()
+	nm, _ := m.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	if nm.(dashboardModel).region != "" {
+		t.Error("expected no region filter without Decoded.Filter")
+	}
+	if strings.Contains(m.View().Content, "region") {
+		t.Error("expected no region key without Decoded.Filter")
+	}
+}
+
+func TestCycleRegion(t *testing.T) {
+	placements := countryPlacements()
+	region := ""
+	var seen []string
+	for range 5 {
+		region = cycleRegion(placements, region)
+		seen = append(seen, region)
+	}
+	want := []string{"NA", "EU", "APAC", "Other", ""}
+	if !reflect.DeepEqual(seen, want) {
+		t.Errorf("cycleRegion = %v, want %v", seen, want)
+	}
+}
+
+func TestSamplesInRegion(t *testing.T) {
+	samples := []trendSample{{ref: TournamentRef{Location: "Sydney"}, placements: countryPlacements()}}
+
+	apac := samplesInRegion(samples, "APAC")
+	if len(apac) != 1 || apac[0].ref.Location != "Sydney" {
+		t.Fatalf("expected the tournament to be kept, got %+v", apac)
+	}
+	var ranks []int
+	for _, p := range apac[0].placements {
+		ranks = append(ranks, p.Rank)
+	}
+	if !reflect.DeepEqual(ranks, []int{1, 3, 40}) {
+		t.Errorf("APAC ranks = %v, want the whole field's [1 3 40]", ranks)
+	}
+	if got := samplesInRegion(samples, ""); len(got[0].placements) != 7 {
+		t.Errorf("expected every placement without a region, got %d", len(got[0].placements))
+	}
+}
+
+func TestDashboard_RegionFilter_OverviewAndMatchups(t *testing.T) {
+	m := newTestDashboard()
+	m.spec.Tabs = append(m.spec.Tabs, MatchupsTab)
+	m.spec.Matchups = "Decks"
+	nm, _ := m.Update(dataMsg{decoded: filterableDecoded(countryPlacements())})
+	m = nm.(dashboardModel)
+	for m.region != "APAC" {
+		nm, _ = m.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+		m = nm.(dashboardModel)
+	}
+
+	if content := m.View().Content; !strings.Contains(content, "OVERVIEW-BODY") || strings.Contains(content, "REGION-OVERVIEW") {
+		t.Errorf("expected the whole tournament's overview, got:\n%s", content)
+	}
+
+	m.activeTab = m.matchupTab()
+	m.matchups = &matchupData{loaded: true, samples: []trendSample{{placements: []Placement{
+		{Rank: 1, Country: "Japan", CountryCode: "JP", Wins: 8, Losses: 2, Labels: map[string][]string{"Decks": {"Charizard ex"}}},
+		{Rank: 2, Country: "Japan", CountryCode: "JP", Wins: 6, Losses: 4, Labels: map[string][]string{"Decks": {"Gardevoir ex"}}},
+		{Rank: 3, Country: "Germany", CountryCode: "DE", Wins: 5, Losses: 5, Labels: map[string][]string{"Decks": {"Dragapult ex"}}},
+	}}}}
+	content := styling.StripANSI(m.View().Content)
+	if !strings.Contains(content, "Players from APAC only.") || !strings.Contains(content, "Gardevoir ex") {
+		t.Errorf("expected the APAC matchups, got:\n%s", content)
+	}
+	if strings.Contains(content, "Dragapult ex") {
+		t.Error("expected players from other regions to be left out of the matchups")
+	}
+}
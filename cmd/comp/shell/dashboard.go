@@ -2,6 +2,7 @@ package shell
 
 import (
 	"fmt"
+	"slices"
 
 	"charm.land/bubbles/v2/table"
 	tea "charm.land/bubbletea/v2"
@@ -17,11 +18,31 @@ type dashboardModel struct {
 	width      int
 	height     int
 	tournament string
-	decoded    *Decoded
-	table      table.Model
-	extraTable table.Model
-	goBack     bool
-	err        error
+	// source is the whole tournament and decoded what the tabs show, which is
+	// source filtered to region when one is picked.
+	source       *Decoded
+	decoded      *Decoded
+	region       string
+	table        table.Model
+	extraTable   table.Model
+	countryTable table.Model
+	// matchups holds the latest tournaments for the matchup matrix, loaded
+	// the first time its tab is opened.
+	matchups *matchupData
+	player   *playerView
+	sheet    *sheetView
+	goBack   bool
+	err      error
+}
+
+// MatchupsTab is the name of the matchup matrix tab. A Spec with Matchups set
+// lists it in Tabs. Standings have no pairings, so the matrix is an estimate.
+const MatchupsTab = "Expected Matchups"
+
+type matchupData struct {
+	samples []trendSample
+	loaded  bool
+	err     error
 }
 
 type dataMsg struct {
@@ -75,6 +96,115 @@ func (m *dashboardModel) buildTables() {
 	}
 	m.table = newTable(m.spec, m.decoded.TableRows, m.width, m.height)
 	m.extraTable = newUsageTable(m.decoded.Extra, len(m.decoded.TableRows), m.width, m.height)
+	m.countryTable = newCountryTable(countryStats(m.decoded.Placements, m.countryCategory()), m.width, m.height)
+	if m.player != nil && m.player.profile != nil {
+		v := *m.player
+		v.table = newPlayerTable(*v.profile, m.width, m.height)
+		m.player = &v
+	}
+}
+
+// loadMatchups starts loading the latest tournaments the first time the
+// matchup tab is opened.
+func (m dashboardModel) loadMatchups() (dashboardModel, tea.Cmd) {
+	if m.activeTab != m.matchupTab() || m.matchups != nil {
+		return m, nil
+	}
+	m.matchups = &matchupData{}
+	return m, fetchTrends(m.spec, m.conn)
+}
+
+// matchupTab is the position of the matchup matrix tab, or -1 when the spec
+// doesn't have one.
+func (m dashboardModel) matchupTab() int {
+	if m.spec.Matchups == "" {
+		return -1
+	}
+	return slices.Index(m.spec.Tabs, MatchupsTab)
+}
+
+// countryCategory is the trend category whose labels the Countries tab lists
+// as most played.
+func (m dashboardModel) countryCategory() string {
+	if len(m.spec.TrendCategories) == 0 {
+		return ""
+	}
+	return m.spec.TrendCategories[0]
+}
+
+// nextRegion moves the region filter to the next region with players, after
+// the last one going back to all regions.
+func (m dashboardModel) nextRegion() dashboardModel {
+	next := cycleRegion(m.source.Placements, m.region)
+
+	m.region = next
+	if next == "" {
+		m.decoded = m.source
+	} else {
+		placements := m.source.Placements
+		filtered := m.source.Filter(func(i int) bool {
+			return i < len(placements) && placements[i].inRegion(next)
+		})
+		m.decoded = &filtered
+	}
+	m.buildTables()
+	return m
+}
+
+// openPlayer starts loading the history of the player in the selected standings row.
+func (m dashboardModel) openPlayer() (dashboardModel, tea.Cmd) {
+	if m.spec.PlayerURL == nil || m.spec.DecodePlayer == nil {
+		return m, nil
+	}
+	col := nameColumn(m.spec.Columns(m.width - 8))
+	row := m.table.SelectedRow()
+	if col < 0 || col >= len(row) || row[col] == "" {
+		return m, nil
+	}
+	m.player = &playerView{name: row[col]}
+	return m, fetchPlayer(m.spec, row[col], m.conn)
+}
+
+// openSheet shows the detail sheet for the selected standings row.
+func (m dashboardModel) openSheet() (dashboardModel, tea.Cmd) {
+	if m.decoded.Sheet == nil {
+		return m, nil
+	}
+	sheet, ok := m.decoded.Sheet(m.table.Cursor())
+	if !ok {
+		return m, nil
+	}
+	m.sheet = &sheetView{sheet: sheet, types: map[string]string{}}
+	return m, fetchSheetTypes(sheet.Links)
+}
+
+func (m dashboardModel) updateSheet(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "esc":
+		return m, tea.Quit
+	}
+	v, cmd, open := m.sheet.update(msg)
+	if !open {
+		m.sheet = nil
+		return m, nil
+	}
+	m.sheet = &v
+	return m, cmd
+}
+
+func (m dashboardModel) updatePlayer(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "esc":
+		return m, tea.Quit
+	case "b":
+		m.player = nil
+		return m, nil
+	}
+	v := *m.player
+	var cmd tea.Cmd
+	v.table, cmd = v.table.Update(msg)
+	m.player = &v
+	return m, cmd
 }
 
 func (m dashboardModel) Init() tea.Cmd {
@@ -84,9 +214,27 @@ func (m dashboardModel) Init() tea.Cmd {
 func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
+		if m.player != nil {
+			return m.updatePlayer(msg)
+		}
+		if m.sheet != nil {
+			return m.updateSheet(msg)
+		}
 		switch msg.String() {
 		case "ctrl+c", "esc":
 			return m, tea.Quit
+		case "enter":
+			if m.decoded != nil && m.activeTab == 1 {
+				return m.openPlayer()
+			}
+		case "s":
+			if m.decoded != nil && m.activeTab == 1 {
+				return m.openSheet()
+			}
+		case "r":
+			if m.source != nil && m.source.Filter != nil {
+				return m.nextRegion(), nil
+			}
 		case "b":
 			m.goBack = true
 			return m, tea.Quit
@@ -94,7 +242,7 @@ func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, utils.Open("https://web.poke-cli.com/")
 		case "right", "l", "tab":
 			m.activeTab = min(m.activeTab+1, len(m.spec.Tabs)-1)
-			return m, nil
+			return m.loadMatchups()
 		case "left", "h", "shift+tab":
 			m.activeTab = max(m.activeTab-1, 0)
 			return m, nil
@@ -106,6 +254,8 @@ func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.table, cmd = m.table.Update(msg)
 			case 2:
 				m.extraTable, cmd = m.extraTable.Update(msg)
+			case 3:
+				m.countryTable, cmd = m.countryTable.Update(msg)
 			}
 			return m, cmd
 		}
@@ -118,13 +268,65 @@ func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case sheetTypesMsg:
+		if m.sheet != nil {
+			v := *m.sheet
+			v.types = msg.types
+			m.sheet = &v
+		}
+		return m, nil
+
+	case lookupMsg:
+		// Drop results for lookups that were cancelled or replaced
+		if m.sheet != nil && m.sheet.loading == msg.label {
+			v := *m.sheet
+			v.loading = ""
+			v.lookup = &msg
+			m.sheet = &v
+		}
+		return m, nil
+
+	case sheetSavedMsg:
+		if m.sheet != nil {
+			v := *m.sheet
+			if msg.err != nil {
+				v.status = styling.Red.Render("Could not save: " + msg.err.Error())
+			} else {
+				v.status = styling.Green.Render("Saved to " + msg.path)
+			}
+			m.sheet = &v
+		}
+		return m, nil
+
+	case trendsMsg:
+		if m.matchups != nil {
+			m.matchups = &matchupData{samples: msg.samples, loaded: true, err: msg.err}
+		}
+		return m, nil
+
+	case playerMsg:
+		if m.player == nil {
+			return m, nil
+		}
+		// Copy the view so earlier models keep their own state
+		v := *m.player
+		if msg.err != nil {
+			v.err = msg.err
+		} else {
+			p := msg.profile
+			v.profile = &p
+			v.table = newPlayerTable(p, m.width, m.height)
+		}
+		m.player = &v
+		return m, nil
+
 	case dataMsg:
 		if msg.err != nil {
 			m.err = msg.err
 			return m, nil
 		}
 		d := msg.decoded
-		m.decoded = &d
+		m.source, m.decoded, m.region = &d, &d, ""
 		m.buildTables()
 	}
 
@@ -138,9 +340,16 @@ func (m dashboardModel) renderTab(contentWidth int) string {
 	if m.decoded == nil {
 		return "  Loading..."
 	}
+	if m.player != nil {
+		return m.player.render()
+	}
+	if m.sheet != nil {
+		return m.sheet.render(contentWidth)
+	}
 	switch m.activeTab {
 	case 0:
-		return m.decoded.Overview(contentWidth, styling.ThemeColor)
+		// The winner is the tournament's, whichever region is picked
+		return m.source.Overview(contentWidth, styling.ThemeColor)
 	case 1:
 		return m.table.View()
 	case 2:
@@ -150,7 +359,22 @@ func (m dashboardModel) renderTab(contentWidth int) string {
 		}
 		return view
 	case 3:
-		return BarChart(m.decoded.Countries, contentWidth, 20)
+		if len(m.countryTable.Rows()) == 0 {
+			return BarChart(m.decoded.Countries, contentWidth, 20)
+		}
+		return renderCountries(m.countryTable, regionTallies(m.source.Placements), m.region, contentWidth)
+	case m.matchupTab():
+		switch {
+		case m.matchups == nil || !m.matchups.loaded:
+			return "  Loading the latest tournaments..."
+		case m.matchups.err != nil:
+			return fmt.Sprintf("fetch error: %v", m.matchups.err)
+		case m.region != "":
+			return captionStyle.Render("Players from "+m.region+" only.") + "\n" +
+				renderMatchups(samplesInRegion(m.matchups.samples, m.region), m.spec.Matchups, contentWidth)
+		default:
+			return renderMatchups(m.matchups.samples, m.spec.Matchups, contentWidth)
+		}
 	}
 	return ""
 }
@@ -160,9 +384,47 @@ func (m dashboardModel) View() tea.View {
 		return tea.NewView("")
 	}
 
-	body := m.styles.Render(m.spec.Tabs, m.activeTab, m.width, m.renderTab)
+	menu := KeyMenu
+	switch {
+	case m.player != nil:
+		menu = playerKeyMenu
+	case m.sheet != nil:
+		menu = m.sheet.menu()
+	case m.activeTab == 1:
+		menu = m.standingsMenu()
+	case m.activeTab == m.matchupTab():
+		menu = "← → (switch tab)" + m.regionKey() + " • cells are estimates • b (back) • w (web) • ctrl+c | esc (quit)"
+	case m.regionKey() != "":
+		menu = "← → (switch tab)" + m.regionKey() + " • b (back) • w (web) • ctrl+c | esc (quit)"
+	}
+	body := m.styles.RenderWithMenu(m.spec.Tabs, m.activeTab, m.width, menu, m.renderTab)
 
 	v := tea.NewView(body)
 	v.AltScreen = true
 	return v
 }
+
+// standingsMenu adds the row keys the competition supports to the key menu.
+func (m dashboardModel) standingsMenu() string {
+	menu := "← → (switch tab)" + m.regionKey()
+	if m.spec.PlayerURL != nil {
+		menu += " • enter (player)"
+	}
+	if m.decoded != nil && m.decoded.Sheet != nil {
+		menu += " • s (team sheet)"
+	}
+	return menu + " • b (back) • w (web) • ctrl+c | esc (quit)"
+}
+
+// regionKey is the region filter's key hint, naming the current region, or
+// empty when the competition can't be filtered.
+func (m dashboardModel) regionKey() string {
+	if m.source == nil || m.source.Filter == nil {
+		return ""
+	}
+	region := m.region
+	if region == "" {
+		region = "all"
+	}
+	return " • r (region: " + region + ")"
+}
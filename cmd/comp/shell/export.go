@@ -0,0 +1,230 @@
+package shell
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Table is one dataset in an export, such as standings or deck counts.
+// Columns are snake_case keys; each row holds ints, float64s, strings or []strings.
+type Table struct {
+	Name    string
+	Columns []string
+	Rows    [][]any
+}
+
+// Export is everything dumped by 'comp <competition> export'.
+type Export struct {
+	Competition string
+	Tournament  string
+	Date        string
+	Tables      []Table
+}
+
+// TallyTable turns frequency counts into a table sorted by count, then label.
+func TallyTable(name, labelColumn, countColumn string, items []Tally) Table {
+	sorted := make([]Tally, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Label < sorted[j].Label
+	})
+
+	t := Table{Name: name, Columns: []string{labelColumn, countColumn}, Rows: make([][]any, len(sorted))}
+	for i, it := range sorted {
+		t.Rows[i] = []any{it.Label, it.Count}
+	}
+	return t
+}
+
+// FindTournament looks up a tournament by location, ignoring case.
+// An empty location picks the most recent tournament.
+func FindTournament(spec Spec, conn ConnFunc, location string) (TournamentRef, error) {
+	msg, _ := fetchTournaments(spec, conn)().(tournamentsDataMsg)
+	if msg.err != nil {
+		return TournamentRef{}, msg.err
+	}
+	if len(msg.tournaments) == 0 {
+		return TournamentRef{}, fmt.Errorf("no tournaments found")
+	}
+	if location == "" {
+		return msg.tournaments[0], nil
+	}
+
+	names := make([]string, len(msg.tournaments))
+	for i, t := range msg.tournaments {
+		if strings.EqualFold(t.Location, location) {
+			return t, nil
+		}
+		names[i] = t.Location
+	}
+	return TournamentRef{}, fmt.Errorf("no tournament found for %q\nAvailable tournaments: %s", location, strings.Join(names, ", "))
+}
+
+// ExportTournament fetches and decodes a tournament's dashboard data for export.
+func ExportTournament(competition string, spec Spec, conn ConnFunc, location string) (Export, error) {
+	ref, err := FindTournament(spec, conn, location)
+	if err != nil {
+		return Export{}, err
+	}
+
+	body, err := conn(spec.DashboardURL(ref.Location))
+	if err != nil {
+		return Export{}, err
+	}
+
+	d, err := spec.Decode(body)
+	if err != nil {
+		return Export{}, err
+	}
+
+	return Export{
+		Competition: competition,
+		Tournament:  ref.Location,
+		Date:        ref.TextDate,
+		Tables:      d.Tables,
+	}, nil
+}
+
+// Table returns the table with the given name.
+func (e Export) Table(name string) (Table, bool) {
+	for _, t := range e.Tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Table{}, false
+}
+
+// TableNames lists the tables in export order.
+func (e Export) TableNames() []string {
+	names := make([]string, len(e.Tables))
+	for i, t := range e.Tables {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// Only keeps the named table, or returns an error listing the available ones.
+func (e Export) Only(name string) (Export, error) {
+	t, ok := e.Table(name)
+	if !ok {
+		return e, fmt.Errorf("no %q table to export\nAvailable tables: %s", name, strings.Join(e.TableNames(), ", "))
+	}
+	e.Tables = []Table{t}
+	return e, nil
+}
+
+// WriteJSON writes the export as one JSON object. Each table is an array of
+// records whose keys keep the table's column order.
+func (e Export) WriteJSON(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	fmt.Fprintf(&buf, "  \"competition\": %s,\n", mustQuote(e.Competition))
+	if e.Tournament != "" {
+		fmt.Fprintf(&buf, "  \"tournament\": %s,\n", mustQuote(e.Tournament))
+		fmt.Fprintf(&buf, "  \"date\": %s,\n", mustQuote(e.Date))
+	}
+	buf.WriteString("  \"tables\": {")
+	for i, t := range e.Tables {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		fmt.Fprintf(&buf, "\n    %s: [", mustQuote(t.Name))
+		for j, row := range t.Rows {
+			if j > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n      {")
+			for k, col := range t.Columns {
+				if k > 0 {
+					buf.WriteString(", ")
+				}
+				var value any
+				if k < len(row) {
+					value = row[k]
+				}
+				v, err := marshal(value)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(&buf, "%s: %s", mustQuote(col), v)
+			}
+			buf.WriteString("}")
+		}
+		if len(t.Rows) > 0 {
+			buf.WriteString("\n    ")
+		}
+		buf.WriteString("]")
+	}
+	if len(e.Tables) > 0 {
+		buf.WriteString("\n  ")
+	}
+	buf.WriteString("}\n}\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteCSV writes a single table with a header row. List values are joined with "/".
+func (t Table) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.Columns); err != nil {
+		return err
+	}
+	record := make([]string, len(t.Columns))
+	for _, row := range t.Rows {
+		for i := range record {
+			record[i] = ""
+			if i < len(row) {
+				record[i] = csvCell(row[i])
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func csvCell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		return strings.Join(v, "/")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// marshal encodes v without escaping characters like '&', which are common in deck names.
+func marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+func mustQuote(s string) string {
+	b, _ := marshal(s)
+	return string(b)
+}
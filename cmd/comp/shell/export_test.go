@@ -0,0 +1,133 @@
+package shell
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testExport() Export {
+	return Export{
+		Competition: "tcg",
+		Tournament:  "Orlando, FL",
+		Date:        "May 1-3, 2026",
+		Tables: []Table{
+			{
+				Name:    "standings",
+				Columns: []string{"rank", "name", "team"},
+				Rows: [][]any{
+					{1, "Ash", []string{"Pikachu", "Charizard"}},
+					{2, "Misty & Brock", []string{}},
+				},
+			},
+			TallyTable("decks", "deck", "players", []Tally{{Label: "Gardevoir ex", Count: 1}, {Label: "Dragapult ex", Count: 3}, {Label: "Charizard ex", Count: 1}}),
+		},
+	}
+}
+
+func TestTallyTable_SortsByCountThenLabel(t *testing.T) {
+	table := TallyTable("decks", "deck", "players", nil)
+	assert.Empty(t, table.Rows)
+
+	decks := testExport().Tables[1]
+	assert.Equal(t, []string{"deck", "players"}, decks.Columns)
+	assert.Equal(t, [][]any{{"Dragapult ex", 3}, {"Charizard ex", 1}, {"Gardevoir ex", 1}}, decks.Rows)
+}
+
+func TestExport_WriteJSON(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, testExport().WriteJSON(&sb))
+	out := sb.String()
+
+	var parsed struct {
+		Competition string                      `json:"competition"`
+		Tournament  string                      `json:"tournament"`
+		Date        string                      `json:"date"`
+		Tables      map[string][]map[string]any `json:"tables"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &parsed), out)
+
+	assert.Equal(t, "tcg", parsed.Competition)
+	assert.Equal(t, "Orlando, FL", parsed.Tournament)
+	assert.Len(t, parsed.Tables["standings"], 2)
+	assert.Len(t, parsed.Tables["decks"], 3)
+	assert.Equal(t, float64(1), parsed.Tables["standings"][0]["rank"])
+	assert.Equal(t, []any{"Pikachu", "Charizard"}, parsed.Tables["standings"][0]["team"])
+
+	// Keys keep column order and '&' is not escaped
+	assert.Contains(t, out, `{"rank": 1, "name": "Ash", "team": ["Pikachu","Charizard"]}`)
+	assert.Contains(t, out, `"Misty & Brock"`)
+}
+
+func TestExport_WriteJSON_NoTournament(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, Export{Competition: "champions"}.WriteJSON(&sb))
+	assert.Equal(t, "{\n  \"competition\": \"champions\",\n  \"tables\": {}\n}\n", sb.String())
+}
+
+func TestTable_WriteCSV(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, testExport().Tables[0].WriteCSV(&sb))
+	assert.Equal(t, "rank,name,team\n1,Ash,Pikachu/Charizard\n2,Misty & Brock,\n", sb.String())
+}
+
+func TestExport_Only(t *testing.T) {
+	e, err := testExport().Only("decks")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"decks"}, e.TableNames())
+
+	_, err = testExport().Only("usage")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "standings, decks")
+}
+
+func TestFindTournament(t *testing.T) {
+	conn := func(string) ([]byte, error) {
+		return []byte(`[{"location":"Orlando, FL","text_date":"May 1-3"},{"location":"Indianapolis","text_date":"Apr 4-6"}]`), nil
+	}
+
+	ref, err := FindTournament(testSpec(), conn, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Orlando, FL", ref.Location, "empty location should pick the latest")
+
+	ref, err = FindTournament(testSpec(), conn, "indianapolis")
+	require.NoError(t, err)
+	assert.Equal(t, "Indianapolis", ref.Location)
+
+	_, err = FindTournament(testSpec(), conn, "Worlds")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Orlando, FL, Indianapolis")
+
+	_, err = FindTournament(testSpec(), noopConn, "")
+	assert.EqualError(t, err, "no tournaments found")
+}
+
+func TestExportTournament(t *testing.T) {
+	var requested []string
+	conn := func(url string) ([]byte, error) {
+		requested = append(requested, url)
+		if url == testSpec().ListURL {
+			return []byte(`[{"location":"Orlando, FL","text_date":"May 1-3"}]`), nil
+		}
+		return []byte("[]"), nil
+	}
+	spec := testSpec()
+	spec.Decode = func(_ []byte) (Decoded, error) {
+		return Decoded{Tables: testExport().Tables}, nil
+	}
+
+	e, err := ExportTournament("tcg", spec, conn, "orlando, fl")
+	require.NoError(t, err)
+	assert.Equal(t, "Orlando, FL", e.Tournament)
+	assert.Equal(t, "May 1-3", e.Date)
+	assert.Equal(t, []string{"standings", "decks"}, e.TableNames())
+	assert.Equal(t, spec.DashboardURL("Orlando, FL"), requested[1])
+
+	failing := func(string) ([]byte, error) { return nil, errors.New("network error") }
+	_, err = ExportTournament("tcg", spec, failing, "")
+	assert.EqualError(t, err, "network error")
+}
@@ -8,11 +8,17 @@ import (
 	"github.com/digitalghost-dev/poke-cli/styling"
 )
 
-const keyMenu = "← → (switch tab) • b (back) • w (web) • ctrl+c | esc (quit)"
+// KeyMenu is the default key menu shown below dashboards.
+const KeyMenu = "← → (switch tab) • b (back) • w (web) • ctrl+c | esc (quit)"
 
 var captionStyle = lipgloss.NewStyle().Foreground(styling.Gray).Italic(true)
 
 func (s *Styles) Render(tabs []string, activeTab, width int, renderContent func(contentWidth int) string) string {
+	return s.RenderWithMenu(tabs, activeTab, width, KeyMenu, renderContent)
+}
+
+// RenderWithMenu is Render with a custom key menu below the window.
+func (s *Styles) RenderWithMenu(tabs []string, activeTab, width int, menu string, renderContent func(contentWidth int) string) string {
 	doc := strings.Builder{}
 
 	var renderedTabs []string
@@ -56,7 +62,7 @@ func (s *Styles) Render(tabs []string, activeTab, width int, renderContent func(
 	doc.WriteString("\n")
 	doc.WriteString(s.Window.Width(windowWidth).Render(content))
 	doc.WriteString("\n")
-	doc.WriteString(styling.KeyMenu.Render(keyMenu))
+	doc.WriteString(styling.KeyMenu.Render(menu))
 
 	return s.Doc.Render(doc.String())
 }
@@ -0,0 +1,177 @@
+package shell
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const (
+	// matchupSize is how many of the most played labels the matrix compares.
+	matchupSize      = 8
+	matchupCellWidth = 6
+	// minMatchupGames is the fewest games behind both sides of a cell before
+	// it stops being marked as a small sample.
+	minMatchupGames = 100
+)
+
+// ParseRecord reads a "wins - losses - ties" record such as "15 - 1 - 0".
+func ParseRecord(record string) (wins, losses, ties int, ok bool) {
+	parts := strings.Split(record, "-")
+	if len(parts) != 3 {
+		return 0, 0, 0, false
+	}
+	var n [3]int
+	for i, p := range parts {
+		v, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || v < 0 {
+			return 0, 0, 0, false
+		}
+		n[i] = v
+	}
+	return n[0], n[1], n[2], true
+}
+
+// labelRecord is the combined record of every player who brought a label.
+type labelRecord struct {
+	label              string
+	players            int
+	wins, losses, ties int
+}
+
+func (r labelRecord) games() int {
+	return r.wins + r.losses + r.ties
+}
+
+// winRate counts ties as half a win.
+func (r labelRecord) winRate() float64 {
+	if r.games() == 0 {
+		return 0
+	}
+	return (float64(r.wins) + float64(r.ties)/2) / float64(r.games())
+}
+
+// labelRecords adds up the records of each label across the samples, most
+// played first. Placements without a record are left out.
+func labelRecords(samples []trendSample, category string) []labelRecord {
+	byLabel := map[string]*labelRecord{}
+	for _, s := range samples {
+		for _, p := range s.placements {
+			if p.Wins+p.Losses+p.Ties == 0 {
+				continue
+			}
+			seen := map[string]bool{}
+			for _, label := range p.Labels[category] {
+				if label == "" || seen[label] {
+					continue
+				}
+				seen[label] = true
+				r, ok := byLabel[label]
+				if !ok {
+					r = &labelRecord{label: label}
+					byLabel[label] = r
+				}
+				r.players++
+				r.wins += p.Wins
+				r.losses += p.Losses
+				r.ties += p.Ties
+			}
+		}
+	}
+
+	records := make([]labelRecord, 0, len(byLabel))
+	for _, r := range byLabel {
+		records = append(records, *r)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].players != records[j].players {
+			return records[i].players > records[j].players
+		}
+		return records[i].label < records[j].label
+	})
+	return records
+}
+
+// log5 estimates how often a side with win rate a beats one with win rate b.
+func log5(a, b float64) float64 {
+	den := a*(1-b) + b*(1-a)
+	if den == 0 {
+		return 0.5
+	}
+	return a * (1 - b) / den
+}
+
+// matchupMatrix is each row's estimated win rate against each column, in
+// percent, with -1 on the diagonal.
+func matchupMatrix(records []labelRecord) [][]int {
+	matrix := make([][]int, len(records))
+	for i, a := range records {
+		matrix[i] = make([]int, len(records))
+		for j, b := range records {
+			if i == j {
+				matrix[i][j] = -1
+				continue
+			}
+			matrix[i][j] = int(math.Round(log5(a.winRate(), b.winRate()) * 100))
+		}
+	}
+	return matrix
+}
+
+func renderMatchups(samples []trendSample, category string, width int) string {
+	records := labelRecords(samples, category)
+	if len(records) < 2 {
+		return "Not enough records to compare."
+	}
+	records = records[:min(len(records), matchupSize)]
+	matrix := matchupMatrix(records)
+
+	labelWidth := min(max(width-len(records)*matchupCellWidth-12, 16), 28)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-*s", labelWidth, "")
+	for j := range records {
+		fmt.Fprintf(&sb, "%*s", matchupCellWidth, fmt.Sprintf("#%d", j+1))
+	}
+	fmt.Fprintf(&sb, "%*s\n", matchupCellWidth+2, "Games")
+
+	for i, row := range records {
+		label := fmt.Sprintf("#%d %s", i+1, row.label)
+		if len([]rune(label)) > labelWidth-1 {
+			label = string([]rune(label)[:labelWidth-2]) + "…"
+		}
+		fmt.Fprintf(&sb, "%-*s", labelWidth, label)
+		for j, pct := range matrix[i] {
+			if pct < 0 {
+				sb.WriteString(strings.Repeat(" ", matchupCellWidth-1) + "—")
+				continue
+			}
+			text := strconv.Itoa(pct)
+			small := min(row.games(), records[j].games()) < minMatchupGames
+			if small {
+				text += "?"
+			}
+			cell := fmt.Sprintf("%*s", matchupCellWidth, text)
+			switch {
+			case small:
+				cell = captionStyle.Render(cell)
+			case pct >= 55:
+				cell = styling.Green.Render(cell)
+			case pct <= 45:
+				cell = styling.Red.Render(cell)
+			}
+			sb.WriteString(cell)
+		}
+		fmt.Fprintf(&sb, "%*s\n", matchupCellWidth+2, FormatInt(row.games()))
+	}
+
+	caption := captionStyle.Width(width).Render(fmt.Sprintf(
+		"Estimated win rate of each row against each column, in percent, across the %d latest tournaments. "+
+			"Standings don't include pairings, so each cell compares the two overall records (log5). "+
+			"? marks a side with fewer than %d games.",
+		len(samples), minMatchupGames))
+	return caption + "\n\n" + sb.String()
+}
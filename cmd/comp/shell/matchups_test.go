@@ -0,0 +1,114 @@
+package shell
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recordPlacement(deck string, wins, losses, ties int) Placement {
+	return Placement{Wins: wins, Losses: losses, Ties: ties, Labels: map[string][]string{"Decks": {deck}}}
+}
+
+func matchupSamples() []trendSample {
+	return []trendSample{
+		{placements: []Placement{
+			recordPlacement("Gardevoir", 60, 30, 10),
+			recordPlacement("Dragapult", 50, 50, 0),
+			recordPlacement("Dragapult", 20, 20, 0),
+			{Rank: 4, Labels: map[string][]string{"Decks": {"Unrecorded"}}},
+		}},
+		{placements: []Placement{
+			recordPlacement("Gardevoir", 5, 5, 0),
+			recordPlacement("Raging Bolt", 2, 8, 0),
+		}},
+	}
+}
+
+func TestParseRecord(t *testing.T) {
+	w, l, ties, ok := ParseRecord("15 - 1 - 2")
+	assert.True(t, ok)
+	assert.Equal(t, []int{15, 1, 2}, []int{w, l, ties})
+
+	for _, bad := range []string{"", "15 - 1", "a - b - c", "1 - -1 - 0"} {
+		_, _, _, ok := ParseRecord(bad)
+		assert.False(t, ok, bad)
+	}
+}
+
+func TestLabelRecords(t *testing.T) {
+	records := labelRecords(matchupSamples(), "Decks")
+	require.Len(t, records, 3, "placements without a record are left out")
+
+	assert.Equal(t, labelRecord{label: "Dragapult", players: 2, wins: 70, losses: 70}, records[0])
+	assert.Equal(t, labelRecord{label: "Gardevoir", players: 2, wins: 65, losses: 35, ties: 10}, records[1])
+	assert.InDelta(t, 70.0/110, records[1].winRate(), 1e-9, "ties count as half a win")
+	assert.Equal(t, "Raging Bolt", records[2].label)
+}
+
+func TestMatchupMatrix(t *testing.T) {
+	assert.InDelta(t, 0.5, log5(0.6, 0.6), 1e-9)
+	assert.InDelta(t, 0.5, log5(0, 0), 1e-9)
+	assert.InDelta(t, 1.0, log5(1, 0.5), 1e-9)
+
+	matrix := matchupMatrix(labelRecords(matchupSamples(), "Decks"))
+	assert.Equal(t, -1, matrix[0][0])
+	assert.Equal(t, 100, matrix[1][0]+matrix[0][1], "each pair adds up to 100")
+	assert.Greater(t, matrix[1][2], matrix[0][2], "the stronger record is favored against the same deck")
+}
+
+func TestRenderMatchups(t *testing.T) {
+	out := styling.StripANSI(renderMatchups(matchupSamples(), "Decks", 100))
+	assert.Contains(t, out, "across the 2 latest tournaments")
+	assert.Contains(t, out, "#1 Dragapult")
+	assert.Contains(t, out, "?", "Raging Bolt has too few games")
+	assert.Contains(t, out, "—")
+	assert.Contains(t, out, "140", "games per row")
+
+	assert.Equal(t, "Not enough records to compare.", renderMatchups(matchupSamples()[1:], "Items", 100))
+}
+
+func TestDashboard_MatchupTab(t *testing.T) {
+	m := loadedTestDashboard()
+	m.spec.Tabs = append(m.spec.Tabs, MatchupsTab)
+	m.spec.Matchups = "Decks"
+	m.activeTab = 3
+
+	nm, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	m = nm.(dashboardModel)
+	require.Equal(t, 4, m.matchupTab())
+	require.Equal(t, m.matchupTab(), m.activeTab)
+	require.NotNil(t, cmd, "opening the tab loads the latest tournaments")
+	assert.Contains(t, m.View().Content, "Loading the latest tournaments")
+	assert.Contains(t, m.View().Content, "cells are estimates")
+
+	// Coming back to the tab doesn't load them again
+	nm, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	nm, cmd = nm.(dashboardModel).Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	assert.Nil(t, cmd)
+
+	nm, _ = nm.(dashboardModel).Update(trendsMsg{samples: matchupSamples()})
+	m = nm.(dashboardModel)
+	assert.Contains(t, styling.StripANSI(m.View().Content), "#2 Gardevoir")
+
+	nm, _ = m.Update(trendsMsg{err: errors.New("boom")})
+	assert.True(t, strings.Contains(nm.(dashboardModel).View().Content, "fetch error: boom"))
+}
+
+func TestDashboard_NoMatchupTab(t *testing.T) {
+	m := loadedTestDashboard()
+	m.activeTab = 3
+	_, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	assert.Nil(t, cmd)
+
+	// A label key without the tab in the spec has nothing to open
+	m.spec.Matchups = "Decks"
+	assert.Equal(t, -1, m.matchupTab())
+	_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	assert.Nil(t, cmd)
+}
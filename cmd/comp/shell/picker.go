@@ -18,11 +18,12 @@ type TournamentRef struct {
 
 type pickerModel struct {
 	conn        ConnFunc
-	listURL     string
+	spec        Spec
 	tournaments []TournamentRef
 	selected    *TournamentRef
 	error       error
 	goBack      bool
+	trends      bool
 	list        list.Model
 	loading     bool
 	spinner     spinner.Model
@@ -34,13 +35,18 @@ type tournamentsDataMsg struct {
 	err         error
 }
 
-func fetchTournaments(listURL string, conn ConnFunc) tea.Cmd {
+func fetchTournaments(spec Spec, conn ConnFunc) tea.Cmd {
 	return func() tea.Msg {
-		body, err := conn(listURL)
+		body, err := conn(spec.ListURL)
 		if err != nil {
 			return tournamentsDataMsg{err: err}
 		}
 
+		if spec.DecodeList != nil {
+			all, err := spec.DecodeList(body)
+			return tournamentsDataMsg{tournaments: all, err: err}
+		}
+
 		var all []TournamentRef
 		if err = json.Unmarshal(body, &all); err != nil {
 			return tournamentsDataMsg{err: err}
@@ -57,7 +63,7 @@ func newPicker(spec Spec, conn ConnFunc) pickerModel {
 
 	return pickerModel{
 		conn:    conn,
-		listURL: spec.ListURL,
+		spec:    spec,
 		loading: true,
 		spinner: s,
 	}
@@ -66,7 +72,7 @@ func newPicker(spec Spec, conn ConnFunc) pickerModel {
 func (m pickerModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.spinner.Tick,
-		fetchTournaments(m.listURL, m.conn),
+		fetchTournaments(m.spec, m.conn),
 	)
 }
 
@@ -82,6 +88,11 @@ func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		case "w":
 			return m, utils.Open("https://web.poke-cli.com/")
+		case "t":
+			if !m.loading && m.error == nil {
+				m.trends = true
+				return m, tea.Quit
+			}
 		case "enter":
 			idx := m.list.Index()
 			if idx >= 0 && idx < len(m.tournaments) {
@@ -124,8 +135,12 @@ func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			key.WithKeys("w"),
 			key.WithHelp("w", "web"),
 		)
-		l.AdditionalShortHelpKeys = func() []key.Binding { return []key.Binding{backBinding, webBinding} }
-		l.AdditionalFullHelpKeys = func() []key.Binding { return []key.Binding{backBinding, webBinding} }
+		trendsBinding := key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "trends"),
+		)
+		l.AdditionalShortHelpKeys = func() []key.Binding { return []key.Binding{backBinding, webBinding, trendsBinding} }
+		l.AdditionalFullHelpKeys = func() []key.Binding { return []key.Binding{backBinding, webBinding, trendsBinding} }
 
 		m.list = l
 		m.loading = false
@@ -163,6 +178,8 @@ func (m pickerModel) View() tea.View {
 		)
 	} else if m.loading {
 		content = "\n  " + m.spinner.View() + " Loading tournaments...\n\n"
+	} else if m.trends {
+		content = styling.QuitTextStyle.Render("Loading trends...")
 	} else if m.selected != nil {
 		content = styling.QuitTextStyle.Render("Tournament selected:", m.selected.Location+" · "+m.selected.TextDate)
 	} else {
@@ -41,7 +41,7 @@ func TestPicker_InitialState(t *testing.T) {
 
 func TestFetchTournaments_ConnectionError(t *testing.T) {
 	mock := func(_ string) ([]byte, error) { return nil, errors.New("refused") }
-	msg := fetchTournaments("https://x.test", mock)()
+	msg := fetchTournaments(Spec{ListURL: "https://x.test"}, mock)()
 	result := msg.(tournamentsDataMsg)
 	if result.err == nil {
 		t.Error("expected error")
@@ -53,7 +53,7 @@ func TestFetchTournaments_ConnectionError(t *testing.T) {
 
 func TestFetchTournaments_InvalidJSON(t *testing.T) {
 	mock := func(_ string) ([]byte, error) { return []byte("not json"), nil }
-	if fetchTournaments("https://x.test", mock)().(tournamentsDataMsg).err == nil {
+	if fetchTournaments(Spec{ListURL: "https://x.test"}, mock)().(tournamentsDataMsg).err == nil {
 		t.Error("expected unmarshal error")
 	}
 }
@@ -64,7 +64,7 @@ func TestFetchTournaments_Success(t *testing.T) {
 		capturedURL = url
 		return []byte(`[{"location":"London","text_date":"Jan 10-12"}]`), nil
 	}
-	result := fetchTournaments("https://x.test/list", mock)().(tournamentsDataMsg)
+	result := fetchTournaments(Spec{ListURL: "https://x.test/list"}, mock)().(tournamentsDataMsg)
 	if result.err != nil {
 		t.Fatalf("unexpected error: %v", result.err)
 	}
@@ -95,6 +95,21 @@ func TestPicker_Update_Enter_SetsSelected(t *testing.T) {
 	}
 }
 
+func TestPicker_Update_T_OpensTrends(t *testing.T) {
+	newModel, cmd := loadedPicker().Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	if !newModel.(pickerModel).trends {
+		t.Error("expected trends=true after pressing t")
+	}
+	if cmd == nil {
+		t.Error("expected a quit cmd")
+	}
+
+	loading, _ := newPicker(testSpec(), noopConn).Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	if loading.(pickerModel).trends {
+		t.Error("expected t to be ignored while loading")
+	}
+}
+
 func TestPicker_Update_DataMsg_Success(t *testing.T) {
 	m := newPicker(testSpec(), noopConn)
 	newModel, _ := m.Update(tournamentsDataMsg{tournaments: []TournamentRef{{Location: "London"}}})
@@ -0,0 +1,155 @@
+package shell
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/table"
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const playerKeyMenu = "↑ ↓ (scroll) • b (back to standings) • ctrl+c | esc (quit)"
+
+// PlayerResult is one tournament finish in a player's history.
+type PlayerResult struct {
+	Tournament string
+	Date       string
+	Rank       int
+	Players    int
+	Points     int
+	Record     string
+	// Entry is the deck or team the player brought.
+	Entry string
+}
+
+// PlayerProfile is a player's history across every tournament, newest first.
+type PlayerProfile struct {
+	Name        string
+	Country     string
+	EntryHeader string
+	Results     []PlayerResult
+}
+
+// playerView is the dashboard's player screen, opened from a standings row.
+type playerView struct {
+	name    string
+	profile *PlayerProfile
+	err     error
+	table   table.Model
+}
+
+type playerMsg struct {
+	profile PlayerProfile
+	err     error
+}
+
+func fetchPlayer(spec Spec, name string, conn ConnFunc) tea.Cmd {
+	return func() tea.Msg {
+		body, err := conn(spec.PlayerURL(name))
+		if err != nil {
+			return playerMsg{err: err}
+		}
+		profile, err := spec.DecodePlayer(body)
+		if err != nil {
+			return playerMsg{err: err}
+		}
+		if profile.Name == "" {
+			profile.Name = name
+		}
+		return playerMsg{profile: profile}
+	}
+}
+
+// nameColumn finds the standings column holding player names.
+func nameColumn(columns []table.Column) int {
+	for i, c := range columns {
+		if c.Title == "Name" {
+			return i
+		}
+	}
+	return -1
+}
+
+func newPlayerTable(p PlayerProfile, width, height int) table.Model {
+	const dateW, rankW, pointsW, recordW = 22, 12, 6, 12
+	avail := width - 8
+	separators := 6 * 2
+	flex := max(avail-dateW-rankW-pointsW-recordW-separators, 40)
+	tournamentW := min(max(flex*2/5, 16), 28)
+	entryW := min(max(flex-tournamentW, 20), 60)
+
+	entryHeader := p.EntryHeader
+	if entryHeader == "" {
+		entryHeader = "Entry"
+	}
+	columns := []table.Column{
+		{Title: "Date", Width: dateW},
+		{Title: "Tournament", Width: tournamentW},
+		{Title: "Finish", Width: rankW},
+		{Title: "Points", Width: pointsW},
+		{Title: "Record", Width: recordW},
+		{Title: entryHeader, Width: entryW},
+	}
+
+	rows := make([]table.Row, len(p.Results))
+	for i, r := range p.Results {
+		finish := "#" + strconv.Itoa(r.Rank)
+		if r.Players > 0 {
+			finish += " / " + FormatInt(r.Players)
+		}
+		rows[i] = table.Row{r.Date, r.Tournament, finish, strconv.Itoa(r.Points), r.Record, r.Entry}
+	}
+
+	tableWidth := len(columns) * 2
+	for _, c := range columns {
+		tableWidth += c.Width
+	}
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(max(height-18, 5)),
+		table.WithWidth(tableWidth),
+	)
+	t.SetStyles(TableStyles())
+	return t
+}
+
+// summary is the line above the results table, e.g. "Japan · 4 tournaments · best finish #2".
+func (p PlayerProfile) summary() string {
+	var parts []string
+	if p.Country != "" {
+		parts = append(parts, p.Country)
+	}
+	noun := "tournaments"
+	if len(p.Results) == 1 {
+		noun = "tournament"
+	}
+	parts = append(parts, fmt.Sprintf("%d %s", len(p.Results), noun))
+
+	best := 0
+	for _, r := range p.Results {
+		if best == 0 || (r.Rank > 0 && r.Rank < best) {
+			best = r.Rank
+		}
+	}
+	if best > 0 {
+		parts = append(parts, fmt.Sprintf("best finish #%d", best))
+	}
+	return strings.Join(parts, "  ·  ")
+}
+
+func (v playerView) render() string {
+	title := styling.StyleBold.Render(v.name)
+	switch {
+	case v.err != nil:
+		return title + "\n\n" + fmt.Sprintf("fetch error: %v", v.err)
+	case v.profile == nil:
+		return title + "\n\n  Loading player history..."
+	case len(v.profile.Results) == 0:
+		return title + "\n\n  No results found for this player."
+	}
+	return title + "\n" + captionStyle.Render(v.profile.summary()) + "\n\n" + v.table.View()
+}
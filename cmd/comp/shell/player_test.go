@@ -0,0 +1,138 @@
+package shell
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/table"
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProfile() PlayerProfile {
+	return PlayerProfile{
+		Name:        "Ash",
+		Country:     "USA",
+		EntryHeader: "Deck",
+		Results: []PlayerResult{
+			{Tournament: "London", Date: "Jan 10", Rank: 5, Players: 1200, Points: 38, Record: "12 - 3 - 1", Entry: "PLAYER-DECK"},
+			{Tournament: "Dallas", Date: "Dec 2", Rank: 2, Points: 44, Record: "14 - 2 - 0", Entry: "OTHER-DECK"},
+		},
+	}
+}
+
+func playerSpec() Spec {
+	spec := testSpec()
+	spec.PlayerURL = func(name string) string { return "https://example.test/player?name=" + name }
+	spec.DecodePlayer = func(_ []byte) (PlayerProfile, error) { return testProfile(), nil }
+	return spec
+}
+
+func TestNameColumn(t *testing.T) {
+	assert.Equal(t, 1, nameColumn([]table.Column{{Title: "Rank"}, {Title: "Name"}}))
+	assert.Equal(t, -1, nameColumn([]table.Column{{Title: "Rank"}}))
+}
+
+func TestPlayerProfile_Summary(t *testing.T) {
+	assert.Equal(t, "USA  ·  2 tournaments  ·  best finish #2", testProfile().summary())
+	assert.Equal(t, "1 tournament  ·  best finish #9", PlayerProfile{Results: []PlayerResult{{Rank: 9}}}.summary())
+}
+
+func TestFetchPlayer(t *testing.T) {
+	var requested string
+	conn := func(url string) ([]byte, error) {
+		requested = url
+		return []byte("[]"), nil
+	}
+	msg := fetchPlayer(playerSpec(), "Ash", conn)().(playerMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, "https://example.test/player?name=Ash", requested)
+	assert.Len(t, msg.profile.Results, 2)
+
+	failing := func(string) ([]byte, error) { return nil, errors.New("network error") }
+	msg = fetchPlayer(playerSpec(), "Ash", failing)().(playerMsg)
+	assert.EqualError(t, msg.err, "network error")
+}
+
+func TestFetchPlayer_FillsMissingName(t *testing.T) {
+	spec := playerSpec()
+	spec.DecodePlayer = func(_ []byte) (PlayerProfile, error) { return PlayerProfile{}, nil }
+	msg := fetchPlayer(spec, "Misty", noopConn)().(playerMsg)
+	assert.Equal(t, "Misty", msg.profile.Name)
+}
+
+func standingsDashboard() dashboardModel {
+	m := newTestDashboard()
+	m.spec = playerSpec()
+	nm, _ := m.Update(dataMsg{decoded: testDecoded()})
+	m = nm.(dashboardModel)
+	m.activeTab = 1
+	return m
+}
+
+func TestDashboard_Enter_OpensPlayer(t *testing.T) {
+	m := standingsDashboard()
+
+	nm, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = nm.(dashboardModel)
+	require.NotNil(t, m.player)
+	assert.Equal(t, "Ash", m.player.name)
+	require.NotNil(t, cmd)
+	assert.Contains(t, styling.StripANSI(m.View().Content), "Loading player history")
+
+	nm, _ = m.Update(cmd())
+	m = nm.(dashboardModel)
+	view := styling.StripANSI(m.View().Content)
+	for _, want := range []string{"Ash", "USA  ·  2 tournaments", "London", "#5 / 1,200", "PLAYER-DECK", "Deck", "b (back to standings)"} {
+		assert.Contains(t, view, want)
+	}
+
+	nm, _ = m.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	m = nm.(dashboardModel)
+	assert.Nil(t, m.player, "b should close the player view")
+	assert.False(t, m.goBack, "b should not leave the dashboard from the player view")
+	assert.Contains(t, styling.StripANSI(m.View().Content), "enter (player)")
+}
+
+func TestDashboard_Enter_IgnoredOutsideStandings(t *testing.T) {
+	m := standingsDashboard()
+	m.activeTab = 0
+	nm, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.Nil(t, nm.(dashboardModel).player)
+	assert.Nil(t, cmd)
+
+	// Specs without player lookup ignore enter
+	plain := loadedTestDashboard()
+	plain.activeTab = 1
+	nm, cmd = plain.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.Nil(t, nm.(dashboardModel).player)
+	assert.Nil(t, cmd)
+}
+
+func TestDashboard_PlayerStates(t *testing.T) {
+	m := standingsDashboard()
+	m.player = &playerView{name: "Ash"}
+
+	nm, _ := m.Update(playerMsg{err: errors.New("boom")})
+	assert.Contains(t, styling.StripANSI(nm.(dashboardModel).View().Content), "fetch error: boom")
+
+	nm, _ = m.Update(playerMsg{profile: PlayerProfile{Name: "Ash"}})
+	assert.Contains(t, styling.StripANSI(nm.(dashboardModel).View().Content), "No results found for this player.")
+
+	// A late reply after closing the view is dropped
+	closed := standingsDashboard()
+	nm, _ = closed.Update(playerMsg{profile: testProfile()})
+	assert.Nil(t, nm.(dashboardModel).player)
+}
+
+func TestDashboard_PlayerView_Resize(t *testing.T) {
+	m := standingsDashboard()
+	p := testProfile()
+	m.player = &playerView{name: "Ash", profile: &p}
+	nm, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 50})
+	view := styling.StripANSI(nm.(dashboardModel).View().Content)
+	assert.True(t, strings.Contains(view, "OTHER-DECK"))
+}
@@ -24,14 +24,35 @@ type Decoded struct {
 	Overview  func(contentWidth int, highlight color.Color) string
 	Extra     Frequency
 	Countries []Tally
+	// Tables is the same data laid out for 'comp <competition> export'.
+	Tables []Table
+	// Placements feed the trends view, in rank order.
+	Placements []Placement
+	// Sheet returns the detail sheet for a standings row, if the competition has one.
+	Sheet func(index int) (Sheet, bool)
+	// Filter rebuilds the data from the standings rows that keep returns true
+	// for, such as the players from one region. Nil when it can't be filtered.
+	// The dashboard keeps the whole tournament's Overview either way.
+	Filter func(keep func(index int) bool) Decoded
 }
 
 type Spec struct {
-	Tabs         []string
-	ListURL      string
+	Tabs    []string
+	ListURL string
+	// DecodeList reads the tournament list, newest first. When nil the list is
+	// read as an array of TournamentRef.
+	DecodeList   func(body []byte) ([]TournamentRef, error)
 	DashboardURL func(location string) string
 	Columns      func(width int) []table.Column
 	Decode       func(body []byte) (Decoded, error)
+	// TrendCategories are the Placement label keys the trends view can switch between.
+	TrendCategories []string
+	// Matchups is the Placement label key compared in the MatchupsTab, a
+	// matrix of expected matchups. Empty when the competition has no such tab.
+	Matchups string
+	// PlayerURL and DecodePlayer load one player's history across all tournaments.
+	PlayerURL    func(name string) string
+	DecodePlayer func(body []byte) (PlayerProfile, error)
 }
 
 func Run(spec Spec, conn ConnFunc) (back bool, err error) {
@@ -59,7 +80,19 @@ func Run(spec Spec, conn ConnFunc) (back bool, err error) {
 		return result, nil
 	}
 
-	return loop(spec, conn, runPicker, runDashboard)
+	runTrends := func(m trendsModel) (trendsModel, error) {
+		final, err := tea.NewProgram(m).Run()
+		if err != nil {
+			return trendsModel{}, err
+		}
+		result, ok := final.(trendsModel)
+		if !ok {
+			return trendsModel{}, fmt.Errorf("unexpected model type from trends: got %T, want trendsModel", final)
+		}
+		return result, nil
+	}
+
+	return loop(spec, conn, runPicker, runDashboard, runTrends)
 }
 
 func loop(
@@ -67,12 +100,23 @@ func loop(
 	conn ConnFunc,
 	runPicker func(pickerModel) (pickerModel, error),
 	runDashboard func(dashboardModel) (dashboardModel, error),
+	runTrends func(trendsModel) (trendsModel, error),
 ) (back bool, err error) {
 	for {
 		result, err := runPicker(newPicker(spec, conn))
 		if err != nil {
 			return false, fmt.Errorf("error running tournament selection program: %w", err)
 		}
+		if result.trends {
+			trends, err := runTrends(newTrends(spec, conn))
+			if err != nil {
+				return false, fmt.Errorf("error running trends program: %w", err)
+			}
+			if !trends.goBack {
+				return false, nil
+			}
+			continue
+		}
 		if result.selected == nil {
 			return result.goBack, nil
 		}
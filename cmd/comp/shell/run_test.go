@@ -66,7 +66,7 @@ func TestLoop_NoTournamentSelected(t *testing.T) {
 		dashCalled = true
 		return dashboardModel{}, nil
 	}
-	back, err := loop(testSpec(), noopConn, runPicker, runDashboard)
+	back, err := loop(testSpec(), noopConn, runPicker, runDashboard, nil)
 	require.NoError(t, err)
 	require.False(t, back, "esc/quit from the picker should not return to the selection menu")
 	require.False(t, dashCalled, "dashboard should not launch when no tournament is selected")
@@ -74,7 +74,7 @@ func TestLoop_NoTournamentSelected(t *testing.T) {
 
 func TestLoop_PickerGoBack_ReturnsBack(t *testing.T) {
 	runPicker := func(_ pickerModel) (pickerModel, error) { return pickerModel{selected: nil, goBack: true}, nil }
-	back, err := loop(testSpec(), noopConn, runPicker, nil)
+	back, err := loop(testSpec(), noopConn, runPicker, nil, nil)
 	require.NoError(t, err)
 	require.True(t, back, "pressing b in the picker should return to the selection menu")
 }
@@ -86,7 +86,7 @@ func TestLoop_TournamentSelected_DashboardExits(t *testing.T) {
 		assert.Equal(t, "London", m.tournament)
 		return dashboardModel{goBack: false}, nil
 	}
-	back, err := loop(testSpec(), noopConn, runPicker, runDashboard)
+	back, err := loop(testSpec(), noopConn, runPicker, runDashboard, nil)
 	require.NoError(t, err)
 	assert.False(t, back, "quitting the dashboard should not return to the selection menu")
 }
@@ -102,7 +102,7 @@ func TestLoop_GoBack_LoopsToPicker(t *testing.T) {
 		return pickerModel{selected: nil}, nil
 	}
 	runDashboard := func(_ dashboardModel) (dashboardModel, error) { return dashboardModel{goBack: true}, nil }
-	back, err := loop(testSpec(), noopConn, runPicker, runDashboard)
+	back, err := loop(testSpec(), noopConn, runPicker, runDashboard, nil)
 	require.NoError(t, err)
 	require.False(t, back)
 	require.Equal(t, 2, calls, "expected the picker to run twice")
@@ -110,7 +110,7 @@ func TestLoop_GoBack_LoopsToPicker(t *testing.T) {
 
 func TestLoop_PickerError(t *testing.T) {
 	runPicker := func(_ pickerModel) (pickerModel, error) { return pickerModel{}, errors.New("boom") }
-	_, err := loop(testSpec(), noopConn, runPicker, nil)
+	_, err := loop(testSpec(), noopConn, runPicker, nil, nil)
 	assert.ErrorContains(t, err, "tournament selection")
 }
 
@@ -120,6 +120,40 @@ func TestLoop_DashboardError(t *testing.T) {
 	runDashboard := func(_ dashboardModel) (dashboardModel, error) {
 		return dashboardModel{}, errors.New("boom")
 	}
-	_, err := loop(testSpec(), noopConn, runPicker, runDashboard)
+	_, err := loop(testSpec(), noopConn, runPicker, runDashboard, nil)
 	assert.ErrorContains(t, err, "dashboard")
 }
+
+func TestLoop_Trends_BackToPicker(t *testing.T) {
+	calls := 0
+	runPicker := func(_ pickerModel) (pickerModel, error) {
+		calls++
+		if calls == 1 {
+			return pickerModel{trends: true}, nil
+		}
+		return pickerModel{goBack: true}, nil
+	}
+	trendsCalled := false
+	runTrends := func(m trendsModel) (trendsModel, error) {
+		trendsCalled = true
+		assert.Equal(t, testSpec().ListURL, m.spec.ListURL)
+		return trendsModel{goBack: true}, nil
+	}
+	back, err := loop(testSpec(), noopConn, runPicker, nil, runTrends)
+	require.NoError(t, err)
+	assert.True(t, back)
+	assert.True(t, trendsCalled)
+	assert.Equal(t, 2, calls, "pressing b in trends should return to the picker")
+}
+
+func TestLoop_TrendsQuit(t *testing.T) {
+	runPicker := func(_ pickerModel) (pickerModel, error) { return pickerModel{trends: true}, nil }
+	runTrends := func(_ trendsModel) (trendsModel, error) { return trendsModel{}, nil }
+	back, err := loop(testSpec(), noopConn, runPicker, nil, runTrends)
+	require.NoError(t, err)
+	assert.False(t, back)
+
+	failing := func(_ trendsModel) (trendsModel, error) { return trendsModel{}, errors.New("boom") }
+	_, err = loop(testSpec(), noopConn, runPicker, nil, failing)
+	assert.ErrorContains(t, err, "trends")
+}
@@ -0,0 +1,170 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const (
+	sheetKeyMenu  = "↑ ↓ (select) • enter (look up) • c (copy) • x (save) • b (back) • ctrl+c | esc (quit)"
+	lookupKeyMenu = "b (back to sheet) • ctrl+c | esc (quit)"
+)
+
+// Link is a selectable name on a sheet, such as a Pokémon or a move.
+type Link struct {
+	Label string
+	// Lookup returns the output of the matching command, e.g. 'poke-cli move protect'.
+	Lookup func() (string, error)
+	// Type returns the link's type name, used to color it. It may be nil.
+	Type func() (string, error)
+}
+
+// Sheet is a detail panel for one standings row, such as a VGC team sheet.
+type Sheet struct {
+	Title string
+	Links []Link
+	// Paste is the plain-text version copied or saved by the sheet.
+	Paste    string
+	FileName string
+	// Render draws the sheet with the link at cursor highlighted. types maps
+	// link labels to the type names loaded so far.
+	Render func(width, cursor int, types map[string]string) string
+}
+
+type sheetView struct {
+	sheet   Sheet
+	cursor  int
+	types   map[string]string
+	lookup  *lookupMsg
+	loading string
+	status  string
+}
+
+type sheetTypesMsg struct {
+	types map[string]string
+}
+
+type lookupMsg struct {
+	label  string
+	output string
+	err    error
+}
+
+type sheetSavedMsg struct {
+	path string
+	err  error
+}
+
+// fetchSheetTypes looks up every link's type at once. Failed lookups are left
+// out, so those names are drawn without a color.
+func fetchSheetTypes(links []Link) tea.Cmd {
+	return func() tea.Msg {
+		var (
+			mu    sync.Mutex
+			wg    sync.WaitGroup
+			types = map[string]string{}
+		)
+		for _, link := range links {
+			if link.Type == nil {
+				continue
+			}
+			wg.Add(1)
+			go func(link Link) {
+				defer wg.Done()
+				t, err := link.Type()
+				if err != nil || t == "" {
+					return
+				}
+				mu.Lock()
+				types[link.Label] = t
+				mu.Unlock()
+			}(link)
+		}
+		wg.Wait()
+		return sheetTypesMsg{types: types}
+	}
+}
+
+func runLookup(link Link) tea.Cmd {
+	return func() tea.Msg {
+		output, err := link.Lookup()
+		return lookupMsg{label: link.Label, output: output, err: err}
+	}
+}
+
+func saveSheet(dir string, sheet Sheet) tea.Cmd {
+	return func() tea.Msg {
+		path := filepath.Join(dir, sheet.FileName)
+		if err := os.WriteFile(path, []byte(sheet.Paste), 0o644); err != nil { // #nosec G306
+			return sheetSavedMsg{err: err}
+		}
+		return sheetSavedMsg{path: path}
+	}
+}
+
+func (v sheetView) update(msg tea.KeyPressMsg) (sheetView, tea.Cmd, bool) {
+	if v.lookup != nil {
+		if msg.String() == "b" {
+			v.lookup = nil
+		}
+		return v, nil, true
+	}
+	if v.loading != "" {
+		// b cancels the lookup; its result is dropped when it arrives
+		if msg.String() == "b" {
+			v.loading = ""
+		}
+		return v, nil, true
+	}
+
+	switch msg.String() {
+	case "b":
+		return v, nil, false
+	case "up", "k":
+		v.cursor = max(v.cursor-1, 0)
+	case "down", "j":
+		v.cursor = min(v.cursor+1, max(len(v.sheet.Links)-1, 0))
+	case "enter":
+		if v.cursor < len(v.sheet.Links) && v.sheet.Links[v.cursor].Lookup != nil {
+			link := v.sheet.Links[v.cursor]
+			v.loading = link.Label
+			return v, runLookup(link), true
+		}
+	case "c":
+		v.status = styling.Green.Render("Copied to the clipboard.")
+		return v, tea.SetClipboard(v.sheet.Paste), true
+	case "x":
+		return v, saveSheet(".", v.sheet), true
+	}
+	return v, nil, true
+}
+
+func (v sheetView) render(width int) string {
+	if v.lookup != nil {
+		if v.lookup.err != nil {
+			return fmt.Sprintf("lookup error: %v", v.lookup.err)
+		}
+		return v.lookup.output
+	}
+
+	body := styling.StyleBold.Render(v.sheet.Title) + "\n\n" + v.sheet.Render(width, v.cursor, v.types)
+	switch {
+	case v.loading != "":
+		body += "\n\n" + captionStyle.Render("Looking up "+v.loading+"...")
+	case v.status != "":
+		body += "\n\n" + v.status
+	}
+	return body
+}
+
+func (v sheetView) menu() string {
+	if v.lookup != nil {
+		return lookupKeyMenu
+	}
+	return sheetKeyMenu
+}
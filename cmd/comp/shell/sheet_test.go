@@ -0,0 +1,158 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSheet() Sheet {
+	return Sheet{
+		Title: "SHEET-TITLE",
+		Links: []Link{
+			{Label: "Venusaur", Lookup: func() (string, error) { return "VENUSAUR-LOOKUP", nil }, Type: func() (string, error) { return "grass", nil }},
+			{Label: "Protect", Lookup: func() (string, error) { return "", errors.New("boom") }, Type: func() (string, error) { return "", errors.New("offline") }},
+			{Label: "No Type"},
+		},
+		Paste:    "Venusaur\n- Protect\n",
+		FileName: "team.txt",
+		Render: func(_, cursor int, types map[string]string) string {
+			return fmt.Sprintf("cursor=%d types=%v", cursor, types)
+		},
+	}
+}
+
+func sheetDashboard() dashboardModel {
+	m := newTestDashboard()
+	d := testDecoded()
+	d.Sheet = func(i int) (Sheet, bool) { return testSheet(), i == 0 }
+	nm, _ := m.Update(dataMsg{decoded: d})
+	m = nm.(dashboardModel)
+	m.activeTab = 1
+	return m
+}
+
+func press(t *testing.T, m dashboardModel, keys ...tea.KeyPressMsg) (dashboardModel, tea.Cmd) {
+	t.Helper()
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var nm tea.Model
+		nm, cmd = m.Update(k)
+		m = nm.(dashboardModel)
+	}
+	return m, cmd
+}
+
+func TestFetchSheetTypes(t *testing.T) {
+	msg := fetchSheetTypes(testSheet().Links)().(sheetTypesMsg)
+	assert.Equal(t, map[string]string{"Venusaur": "grass"}, msg.types)
+}
+
+func TestSaveSheet(t *testing.T) {
+	dir := t.TempDir()
+	msg := saveSheet(dir, testSheet())().(sheetSavedMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, filepath.Join(dir, "team.txt"), msg.path)
+
+	data, err := os.ReadFile(msg.path)
+	require.NoError(t, err)
+	assert.Equal(t, "Venusaur\n- Protect\n", string(data))
+
+	msg = saveSheet(filepath.Join(dir, "missing"), testSheet())().(sheetSavedMsg)
+	assert.Error(t, msg.err)
+}
+
+func TestDashboard_Sheet_OpenAndNavigate(t *testing.T) {
+	m, cmd := press(t, sheetDashboard(), tea.KeyPressMsg{Code: 's', Text: "s"})
+	require.NotNil(t, m.sheet)
+	require.NotNil(t, cmd, "opening a sheet should load link types")
+
+	nm, _ := m.Update(cmd())
+	m = nm.(dashboardModel)
+	view := styling.StripANSI(m.View().Content)
+	assert.Contains(t, view, "SHEET-TITLE")
+	assert.Contains(t, view, "cursor=0 types=map[Venusaur:grass]")
+	assert.Contains(t, view, "enter (look up)")
+
+	m, _ = press(t, m, tea.KeyPressMsg{Code: tea.KeyDown}, tea.KeyPressMsg{Code: tea.KeyDown}, tea.KeyPressMsg{Code: tea.KeyDown})
+	assert.Equal(t, 2, m.sheet.cursor, "cursor should stop at the last link")
+	m, _ = press(t, m, tea.KeyPressMsg{Code: tea.KeyUp})
+	assert.Equal(t, 1, m.sheet.cursor)
+
+	m, _ = press(t, m, tea.KeyPressMsg{Code: 'b', Text: "b"})
+	assert.Nil(t, m.sheet)
+	assert.False(t, m.goBack)
+	assert.Contains(t, styling.StripANSI(m.View().Content), "s (team sheet)")
+}
+
+func TestDashboard_Sheet_Lookup(t *testing.T) {
+	m, _ := press(t, sheetDashboard(), tea.KeyPressMsg{Code: 's', Text: "s"})
+
+	m, cmd := press(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Contains(t, styling.StripANSI(m.View().Content), "Looking up Venusaur...")
+
+	nm, _ := m.Update(cmd())
+	m = nm.(dashboardModel)
+	view := styling.StripANSI(m.View().Content)
+	assert.Contains(t, view, "VENUSAUR-LOOKUP")
+	assert.Contains(t, view, "b (back to sheet)")
+
+	m, _ = press(t, m, tea.KeyPressMsg{Code: 'b', Text: "b"})
+	require.NotNil(t, m.sheet, "b should return from the lookup to the sheet")
+	assert.Nil(t, m.sheet.lookup)
+
+	// Errors are shown in place of the output
+	m, cmd = press(t, m, tea.KeyPressMsg{Code: tea.KeyDown}, tea.KeyPressMsg{Code: tea.KeyEnter})
+	nm, _ = m.Update(cmd())
+	assert.Contains(t, styling.StripANSI(nm.(dashboardModel).View().Content), "lookup error: boom")
+
+	// A cancelled lookup is dropped
+	m, cmd = press(t, m, tea.KeyPressMsg{Code: 'b', Text: "b"})
+	assert.Nil(t, cmd)
+	assert.Empty(t, m.sheet.loading)
+	nm, _ = m.Update(lookupMsg{label: "Protect", output: "LATE"})
+	assert.Nil(t, nm.(dashboardModel).sheet.lookup)
+
+	// Links without a lookup do nothing
+	m, cmd = press(t, m, tea.KeyPressMsg{Code: tea.KeyDown}, tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.Empty(t, m.sheet.loading)
+}
+
+func TestDashboard_Sheet_CopyAndSave(t *testing.T) {
+	m, _ := press(t, sheetDashboard(), tea.KeyPressMsg{Code: 's', Text: "s"})
+
+	m, cmd := press(t, m, tea.KeyPressMsg{Code: 'c', Text: "c"})
+	assert.NotNil(t, cmd)
+	assert.Contains(t, styling.StripANSI(m.View().Content), "Copied to the clipboard.")
+
+	m, cmd = press(t, m, tea.KeyPressMsg{Code: 'x', Text: "x"})
+	assert.NotNil(t, cmd)
+
+	nm, _ := m.Update(sheetSavedMsg{path: "team.txt"})
+	assert.Contains(t, styling.StripANSI(nm.(dashboardModel).View().Content), "Saved to team.txt")
+	nm, _ = m.Update(sheetSavedMsg{err: errors.New("read-only")})
+	assert.Contains(t, styling.StripANSI(nm.(dashboardModel).View().Content), "Could not save: read-only")
+}
+
+func TestDashboard_Sheet_NotAvailable(t *testing.T) {
+	m := sheetDashboard()
+	m.table.SetCursor(1)
+	m, cmd := press(t, m, tea.KeyPressMsg{Code: 's', Text: "s"})
+	assert.Nil(t, m.sheet)
+	assert.Nil(t, cmd)
+
+	plain := loadedTestDashboard()
+	plain.activeTab = 1
+	plain, _ = press(t, plain, tea.KeyPressMsg{Code: 's', Text: "s"})
+	assert.Nil(t, plain.sheet)
+	assert.NotContains(t, styling.StripANSI(plain.View().Content), "team sheet")
+}
@@ -0,0 +1,412 @@
+package shell
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const (
+	// trendsLimit is how many of the latest tournaments the trends view loads.
+	trendsLimit = 10
+	// topCut is the placement that counts as making the cut for conversion rates.
+	topCut = 32
+	// minConversionPlayers hides archetypes too rare for a meaningful conversion rate.
+	minConversionPlayers = 10
+	trendsLabelWidth     = 24
+	trendsKeyMenu        = "← → (switch tab) • c (category) • r (region) • b (back) • w (web) • ctrl+c | esc (quit)"
+)
+
+var trendsTabs = []string{"Share", "Rising", "Falling", "Conversion"}
+
+// Placement is one player's finish, with the labels they brought per trend
+// category, e.g. {"Decks": ["Gardevoir ex"]}.
+type Placement struct {
+	Rank int
+	// Country is the player's country name and CountryCode its ISO 3166 code,
+	// which places it in a region.
+	Country     string
+	CountryCode string
+	// Wins, Losses and Ties are the player's record, if the competition has one.
+	Wins, Losses, Ties int
+	Labels             map[string][]string
+}
+
+// trendSample is one tournament's placements.
+type trendSample struct {
+	ref        TournamentRef
+	placements []Placement
+}
+
+// players counts how many players used each label, and how many of those made the cut.
+func (s trendSample) players(category string) (field, cut map[string]int) {
+	field, cut = map[string]int{}, map[string]int{}
+	for _, p := range s.placements {
+		seen := map[string]bool{}
+		for _, label := range p.Labels[category] {
+			if label == "" || seen[label] {
+				continue
+			}
+			seen[label] = true
+			field[label]++
+			if p.Rank <= topCut {
+				cut[label]++
+			}
+		}
+	}
+	return field, cut
+}
+
+func (s trendSample) cutSize() int {
+	n := 0
+	for _, p := range s.placements {
+		if p.Rank <= topCut {
+			n++
+		}
+	}
+	return n
+}
+
+// shares returns each label's share of the field per tournament, in sample order.
+func shares(samples []trendSample, category string) map[string][]float64 {
+	out := map[string][]float64{}
+	for i, s := range samples {
+		if len(s.placements) == 0 {
+			continue
+		}
+		field, _ := s.players(category)
+		for label, n := range field {
+			if out[label] == nil {
+				out[label] = make([]float64, len(samples))
+			}
+			out[label][i] = float64(n) / float64(len(s.placements))
+		}
+	}
+	return out
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+func percent(share float64) int {
+	return int(math.Round(share * 100))
+}
+
+// byLabel sorts tallies built from maps so ties always chart in the same order.
+func byLabel(items []Tally) []Tally {
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// averageShare is each label's mean share of the field, in whole percent.
+func averageShare(samples []trendSample, category string) []Tally {
+	var items []Tally
+	for label, s := range shares(samples, category) {
+		items = append(items, Tally{Label: label, Count: percent(mean(s))})
+	}
+	return byLabel(items)
+}
+
+// movers compares the newer half of the samples (samples are oldest first)
+// with the older half and returns the change in share, in percentage points.
+func movers(samples []trendSample, category string) (rising, falling []Tally) {
+	if len(samples) < 2 {
+		return nil, nil
+	}
+	split := len(samples) - len(samples)/2
+	for label, s := range shares(samples, category) {
+		delta := percent(mean(s[split:]) - mean(s[:split]))
+		switch {
+		case delta > 0:
+			rising = append(rising, Tally{Label: label, Count: delta})
+		case delta < 0:
+			falling = append(falling, Tally{Label: label, Count: -delta})
+		}
+	}
+	return byLabel(rising), byLabel(falling)
+}
+
+// conversion divides each label's share of the top cut by its share of the
+// field, across all samples. 100 means it made the cut at its field rate.
+func conversion(samples []trendSample, category string) []Tally {
+	field, cut := map[string]int{}, map[string]int{}
+	players, cutPlayers := 0, 0
+	for _, s := range samples {
+		f, c := s.players(category)
+		for label, n := range f {
+			field[label] += n
+		}
+		for label, n := range c {
+			cut[label] += n
+		}
+		players += len(s.placements)
+		cutPlayers += s.cutSize()
+	}
+	if players == 0 || cutPlayers == 0 {
+		return nil
+	}
+
+	var items []Tally
+	for label, n := range field {
+		if n < minConversionPlayers {
+			continue
+		}
+		fieldShare := float64(n) / float64(players)
+		cutShare := float64(cut[label]) / float64(cutPlayers)
+		items = append(items, Tally{Label: label, Count: percent(cutShare / fieldShare)})
+	}
+	return byLabel(items)
+}
+
+type trendsModel struct {
+	spec      Spec
+	conn      ConnFunc
+	styles    *Styles
+	activeTab int
+	category  int
+	region    string
+	width     int
+	height    int
+	samples   []trendSample
+	loaded    bool
+	goBack    bool
+	err       error
+}
+
+type trendsMsg struct {
+	samples []trendSample
+	err     error
+}
+
+func newTrends(spec Spec, conn ConnFunc) trendsModel {
+	return trendsModel{
+		spec:   spec,
+		conn:   conn,
+		styles: NewStyles(),
+	}
+}
+
+// fetchTrends loads the latest tournaments and returns them oldest first.
+func fetchTrends(spec Spec, conn ConnFunc) tea.Cmd {
+	return func() tea.Msg {
+		list, _ := fetchTournaments(spec, conn)().(tournamentsDataMsg)
+		if list.err != nil {
+			return trendsMsg{err: list.err}
+		}
+
+		refs := list.tournaments[:min(len(list.tournaments), trendsLimit)]
+		samples := make([]trendSample, 0, len(refs))
+		for i := len(refs) - 1; i >= 0; i-- {
+			body, err := conn(spec.DashboardURL(refs[i].Location))
+			if err != nil {
+				return trendsMsg{err: err}
+			}
+			decoded, err := spec.Decode(body)
+			if err != nil {
+				return trendsMsg{err: err}
+			}
+			samples = append(samples, trendSample{ref: refs[i], placements: decoded.Placements})
+		}
+		return trendsMsg{samples: samples}
+	}
+}
+
+func (m trendsModel) Init() tea.Cmd {
+	return fetchTrends(m.spec, m.conn)
+}
+
+func (m trendsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "b":
+			m.goBack = true
+			return m, tea.Quit
+		case "w":
+			return m, utils.Open("https://web.poke-cli.com/")
+		case "right", "l", "tab":
+			m.activeTab = min(m.activeTab+1, len(trendsTabs)-1)
+		case "left", "h", "shift+tab":
+			m.activeTab = max(m.activeTab-1, 0)
+		case "c":
+			if n := len(m.spec.TrendCategories); n > 0 {
+				m.category = (m.category + 1) % n
+			}
+		case "r":
+			var all []Placement
+			for _, s := range m.samples {
+				all = append(all, s.placements...)
+			}
+			m.region = cycleRegion(all, m.region)
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case trendsMsg:
+		m.samples, m.err = msg.samples, msg.err
+		m.loaded = true
+	}
+
+	return m, nil
+}
+
+func (m trendsModel) categoryName() string {
+	if len(m.spec.TrendCategories) == 0 {
+		return ""
+	}
+	return m.spec.TrendCategories[m.category]
+}
+
+// truncateLabels shortens labels so bars stay aligned.
+func truncateLabels(items []Tally, width int) []Tally {
+	out := make([]Tally, len(items))
+	for i, it := range items {
+		out[i] = Tally{Label: truncate(it.Label, width), Count: it.Count}
+	}
+	return out
+}
+
+func truncate(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	r := []rune(s)
+	for len(r) > 0 && lipgloss.Width(string(r))+1 > width {
+		r = r[:len(r)-1]
+	}
+	return string(r) + "…"
+}
+
+func (m trendsModel) renderTab(contentWidth int) string {
+	if m.err != nil {
+		return fmt.Sprintf("fetch error: %v", m.err)
+	}
+	if !m.loaded {
+		return fmt.Sprintf("  Loading the latest %d tournaments...", trendsLimit)
+	}
+	if len(m.samples) == 0 {
+		return "  No tournaments found."
+	}
+
+	category := m.categoryName()
+	header := styling.StyleBold.Render(category) +
+		captionStyle.Render(fmt.Sprintf("  ·  %d tournaments, %s to %s",
+			len(m.samples), m.samples[0].ref.TextDate, m.samples[len(m.samples)-1].ref.TextDate))
+	if len(m.spec.TrendCategories) > 1 {
+		header += captionStyle.Render("  ·  c to switch")
+	}
+	if m.region != "" {
+		header += captionStyle.Render("  ·  players from " + m.region)
+	}
+	samples := samplesInRegion(m.samples, m.region)
+
+	var body, caption string
+	switch m.activeTab {
+	case 0:
+		items := averageShare(samples, category)
+		body = BarChart(truncateLabels(items, trendsLabelWidth), contentWidth, trendsLabelWidth) +
+			"\n" + m.timeline(items, contentWidth)
+		caption = "Average share of the field, in percent."
+	case 1, 2:
+		rising, falling := movers(samples, category)
+		items := rising
+		if m.activeTab == 2 {
+			items = falling
+		}
+		if len(m.samples) < 2 {
+			body = "Trends need at least two tournaments.\n"
+			break
+		}
+		split := len(m.samples) - len(m.samples)/2
+		body = TopBarChart(truncateLabels(items, trendsLabelWidth), contentWidth, trendsLabelWidth)
+		if body == "" {
+			body = "No changes.\n"
+		}
+		caption = fmt.Sprintf("Change in field share, in percentage points: tournaments %s against %s.",
+			span(split+1, len(m.samples)), span(1, split))
+	case 3:
+		body = TopBarChart(truncateLabels(conversion(samples, category), trendsLabelWidth), contentWidth, trendsLabelWidth)
+		if body == "" {
+			body = "Not enough players to compare.\n"
+		}
+		caption = fmt.Sprintf("Share of the top %d divided by share of the field, in percent. 100 means it made the cut at its field rate. "+
+			"Only entries used by at least %d players are shown.", topCut, minConversionPlayers)
+	}
+
+	return header + "\n\n" + body + "\n" + captionStyle.Width(contentWidth).Render(caption)
+}
+
+// span names a run of tournaments by their timeline numbers, e.g. "#1-#5".
+func span(from, to int) string {
+	if from == to {
+		return fmt.Sprintf("#%d", from)
+	}
+	return fmt.Sprintf("#%d-#%d", from, to)
+}
+
+// timeline lists the most used labels' share in each tournament, oldest first.
+func (m trendsModel) timeline(items []Tally, contentWidth int) string {
+	top := make([]Tally, len(items))
+	copy(top, items)
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Label < top[j].Label
+	})
+	top = top[:min(len(top), 5)]
+
+	const cellWidth = 6
+	columns := min(len(m.samples), max((contentWidth-trendsLabelWidth-1)/cellWidth, 1))
+	samples := samplesInRegion(m.samples[len(m.samples)-columns:], m.region)
+	perTournament := shares(samples, m.categoryName())
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-*s", trendsLabelWidth, "")
+	for i := range samples {
+		fmt.Fprintf(&sb, " %*s", cellWidth-1, fmt.Sprintf("#%d", len(m.samples)-columns+i+1))
+	}
+	sb.WriteString("\n")
+	for _, it := range top {
+		fmt.Fprintf(&sb, "%-*s", trendsLabelWidth, truncate(it.Label, trendsLabelWidth))
+		row := perTournament[it.Label]
+		if row == nil {
+			row = make([]float64, len(samples))
+		}
+		for _, share := range row {
+			fmt.Fprintf(&sb, " %*s", cellWidth-1, fmt.Sprintf("%d%%", percent(share)))
+		}
+		sb.WriteString("\n")
+	}
+	return captionStyle.Render("Share per tournament, oldest first") + "\n" + sb.String()
+}
+
+func (m trendsModel) View() tea.View {
+	if m.styles == nil {
+		return tea.NewView("")
+	}
+
+	body := m.styles.RenderWithMenu(trendsTabs, m.activeTab, m.width, trendsKeyMenu, m.renderTab)
+
+	v := tea.NewView(body)
+	v.AltScreen = true
+	return v
+}
@@ -0,0 +1,220 @@
+package shell
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deckSample builds a tournament where each deck is played by the given number of
+// players; players are ranked in the order listed.
+func deckSample(location string, decks ...any) trendSample {
+	s := trendSample{ref: TournamentRef{Location: location, TextDate: location + " date"}}
+	for i := 0; i < len(decks); i += 2 {
+		for range decks[i+1].(int) {
+			s.placements = append(s.placements, Placement{
+				Rank:   len(s.placements) + 1,
+				Labels: map[string][]string{"Decks": {decks[i].(string)}},
+			})
+		}
+	}
+	return s
+}
+
+func tallyMap(items []Tally) map[string]int {
+	m := map[string]int{}
+	for _, it := range items {
+		m[it.Label] = it.Count
+	}
+	return m
+}
+
+func TestShares_AndAverage(t *testing.T) {
+	samples := []trendSample{
+		deckSample("A", "Gardevoir", 1, "Dragapult", 3),
+		deckSample("B", "Gardevoir", 2, "Dragapult", 2),
+	}
+	s := shares(samples, "Decks")
+	assert.Equal(t, []float64{0.25, 0.5}, s["Gardevoir"])
+	assert.Equal(t, []float64{0.75, 0.5}, s["Dragapult"])
+
+	avg := tallyMap(averageShare(samples, "Decks"))
+	assert.Equal(t, 38, avg["Gardevoir"])
+	assert.Equal(t, 63, avg["Dragapult"])
+}
+
+func TestShares_CountsAPlayerOncePerLabel(t *testing.T) {
+	s := trendSample{placements: []Placement{
+		{Rank: 1, Labels: map[string][]string{"Items": {"Focus Sash", "Focus Sash", ""}}},
+		{Rank: 2, Labels: map[string][]string{"Items": {"Leftovers"}}},
+	}}
+	field, _ := s.players("Items")
+	assert.Equal(t, map[string]int{"Focus Sash": 1, "Leftovers": 1}, field)
+}
+
+func TestMovers(t *testing.T) {
+	samples := []trendSample{
+		deckSample("A", "Gardevoir", 1, "Dragapult", 3),
+		deckSample("B", "Gardevoir", 1, "Dragapult", 3),
+		deckSample("C", "Gardevoir", 3, "Dragapult", 1),
+	}
+	// Older: A and B; newer: C
+	rising, falling := movers(samples, "Decks")
+	assert.Equal(t, map[string]int{"Gardevoir": 50}, tallyMap(rising))
+	assert.Equal(t, map[string]int{"Dragapult": 50}, tallyMap(falling))
+
+	rising, falling = movers(samples[:1], "Decks")
+	assert.Nil(t, rising)
+	assert.Nil(t, falling)
+}
+
+func TestConversion(t *testing.T) {
+	// 64 players: Gardevoir takes 24 of the top 32 but only 32 of the field
+	s := deckSample("A", "Gardevoir", 24, "Dragapult", 8, "Gardevoir", 8, "Dragapult", 16, "Rare", 8)
+	got := tallyMap(conversion([]trendSample{s}, "Decks"))
+
+	// Cut share 24/32 over field share 32/64
+	assert.Equal(t, 150, got["Gardevoir"])
+	// Cut share 8/32 over field share 24/64
+	assert.Equal(t, 67, got["Dragapult"])
+	// 8 players is below the minimum
+	_, ok := got["Rare"]
+	assert.False(t, ok)
+
+	assert.Nil(t, conversion(nil, "Decks"))
+}
+
+func TestSpan(t *testing.T) {
+	assert.Equal(t, "#3", span(3, 3))
+	assert.Equal(t, "#1-#5", span(1, 5))
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "Pikachu", truncate("Pikachu", 10))
+	assert.Equal(t, "Gardevoir…", truncate("Gardevoir ex", 10))
+}
+
+func trendsSpec(bodies map[string]string) (Spec, ConnFunc) {
+	spec := testSpec()
+	spec.TrendCategories = []string{"Decks", "Countries"}
+	spec.Decode = func(body []byte) (Decoded, error) {
+		s := deckSample("", strings.Split(string(body), ",")[0], 2, "Other", 2)
+		return Decoded{Placements: s.placements}, nil
+	}
+	conn := func(url string) ([]byte, error) {
+		if url == spec.ListURL {
+			return []byte(`[{"location":"New","text_date":"June"},{"location":"Old","text_date":"May"}]`), nil
+		}
+		for loc, body := range bodies {
+			if url == spec.DashboardURL(loc) {
+				return []byte(body), nil
+			}
+		}
+		return nil, errors.New("unexpected url " + url)
+	}
+	return spec, conn
+}
+
+func TestFetchTrends_OldestFirst(t *testing.T) {
+	spec, conn := trendsSpec(map[string]string{"New": "Gardevoir", "Old": "Dragapult"})
+	msg := fetchTrends(spec, conn)().(trendsMsg)
+	require.NoError(t, msg.err)
+	require.Len(t, msg.samples, 2)
+	assert.Equal(t, "Old", msg.samples[0].ref.Location)
+	assert.Equal(t, "New", msg.samples[1].ref.Location)
+	assert.Len(t, msg.samples[1].placements, 4)
+}
+
+func TestFetchTrends_Error(t *testing.T) {
+	spec, conn := trendsSpec(map[string]string{"New": "Gardevoir"})
+	msg := fetchTrends(spec, conn)().(trendsMsg)
+	assert.ErrorContains(t, msg.err, "unexpected url")
+}
+
+func loadedTrends(t *testing.T) trendsModel {
+	t.Helper()
+	spec, conn := trendsSpec(map[string]string{"New": "Gardevoir", "Old": "Dragapult"})
+	m := newTrends(spec, conn)
+	m.width, m.height = 120, 40
+	nm, _ := m.Update(fetchTrends(spec, conn)())
+	return nm.(trendsModel)
+}
+
+func TestTrends_View_Tabs(t *testing.T) {
+	m := loadedTrends(t)
+
+	tests := []struct {
+		tab  int
+		want []string
+	}{
+		{0, []string{"Decks", "2 tournaments, May to June", "Other", "Share per tournament", "#1", "#2"}},
+		{1, []string{"Gardevoir", "tournaments #2 against #1"}},
+		{2, []string{"Dragapult"}},
+		{3, []string{"Not enough players to compare."}},
+	}
+	for _, tt := range tests {
+		m.activeTab = tt.tab
+		view := styling.StripANSI(m.View().Content)
+		for _, want := range tt.want {
+			assert.Contains(t, view, want, "tab %d", tt.tab)
+		}
+	}
+}
+
+func TestTrends_Update_Keys(t *testing.T) {
+	m := loadedTrends(t)
+
+	nm, _ := m.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	m = nm.(trendsModel)
+	assert.Equal(t, "Countries", m.categoryName())
+	nm, _ = m.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	assert.Equal(t, "Decks", nm.(trendsModel).categoryName(), "categories should wrap around")
+
+	nm, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	assert.Equal(t, 1, nm.(trendsModel).activeTab)
+	nm, _ = nm.(trendsModel).Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	nm, _ = nm.(trendsModel).Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	assert.Equal(t, 0, nm.(trendsModel).activeTab)
+
+	nm, cmd := m.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	assert.True(t, nm.(trendsModel).goBack)
+	assert.NotNil(t, cmd)
+}
+
+func TestTrends_RegionFilter(t *testing.T) {
+	m := loadedTrends(t)
+	deck := func(d string) map[string][]string { return map[string][]string{"Decks": {d}} }
+	m.samples = []trendSample{{ref: TournamentRef{TextDate: "June"}, placements: []Placement{
+		{Rank: 1, Country: "Japan", CountryCode: "JP", Labels: deck("Charizard ex")},
+		{Rank: 2, Country: "Germany", CountryCode: "DE", Labels: deck("Dragapult ex")},
+	}}}
+
+	nm, _ := m.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	m = nm.(trendsModel)
+	assert.Equal(t, "EU", m.region)
+	view := styling.StripANSI(m.View().Content)
+	assert.Contains(t, view, "players from EU")
+	assert.Contains(t, view, "Dragapult ex")
+	assert.NotContains(t, view, "Charizard ex")
+
+	nm, _ = m.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	nm, _ = nm.(trendsModel).Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	assert.Empty(t, nm.(trendsModel).region, "after the last region it goes back to all of them")
+}
+
+func TestTrends_View_LoadingAndError(t *testing.T) {
+	m := newTrends(testSpec(), noopConn)
+	m.width = 120
+	assert.Contains(t, styling.StripANSI(m.View().Content), "Loading the latest 10 tournaments")
+
+	nm, _ := m.Update(trendsMsg{err: errors.New("boom")})
+	assert.Contains(t, styling.StripANSI(nm.(trendsModel).View().Content), "fetch error: boom")
+
+	nm, _ = m.Update(trendsMsg{})
+	assert.Contains(t, styling.StripANSI(nm.(trendsModel).View().Content), "No tournaments found.")
+}
@@ -0,0 +1,168 @@
+package shell
+
+import (
+	"slices"
+	"time"
+)
+
+// Event types emitted by 'comp watch'.
+const (
+	EventNewTournament = "new_tournament"
+	EventTopCutChange  = "top_cut_change"
+)
+
+// Finisher is one player in a tournament's top cut.
+type Finisher struct {
+	Rank int    `json:"rank"`
+	Name string `json:"name"`
+}
+
+// Snapshot is what watch mode remembers about a competition between polls.
+type Snapshot struct {
+	Tournaments []TournamentRef `json:"tournaments"`
+	// TopCut holds the top finishers of the latest tournaments, keyed by TournamentRef.Key.
+	TopCut map[string][]Finisher `json:"top_cut"`
+}
+
+// Event is one change found between two snapshots.
+type Event struct {
+	Type        string     `json:"type"`
+	Competition string     `json:"competition"`
+	Tournament  string     `json:"tournament"`
+	Date        string     `json:"date"`
+	Time        time.Time  `json:"time"`
+	TopCut      []Finisher `json:"top_cut,omitempty"`
+	Entered     []string   `json:"entered,omitempty"`
+	Left        []string   `json:"left,omitempty"`
+}
+
+// Key identifies a tournament across polls. Locations repeat from year to
+// year, so the date is part of it.
+func (t TournamentRef) Key() string {
+	return t.Location + " (" + t.TextDate + ")"
+}
+
+// Tournaments lists every tournament, newest first.
+func Tournaments(spec Spec, conn ConnFunc) ([]TournamentRef, error) {
+	msg, _ := fetchTournaments(spec, conn)().(tournamentsDataMsg)
+	return msg.tournaments, msg.err
+}
+
+// TopCut reads the first size finishers from a decoded standings table.
+func TopCut(d Decoded, size int) []Finisher {
+	var standings Table
+	for _, t := range d.Tables {
+		if t.Name == "standings" {
+			standings = t
+		}
+	}
+	rankCol := slices.Index(standings.Columns, "rank")
+	nameCol := slices.Index(standings.Columns, "name")
+	if rankCol < 0 || nameCol < 0 {
+		return nil
+	}
+
+	var out []Finisher
+	for _, row := range standings.Rows {
+		rank, _ := row[rankCol].(int)
+		name, _ := row[nameCol].(string)
+		if rank < 1 || rank > size {
+			continue
+		}
+		out = append(out, Finisher{Rank: rank, Name: name})
+	}
+	slices.SortStableFunc(out, func(a, b Finisher) int { return a.Rank - b.Rank })
+	return out
+}
+
+// TakeSnapshot records the tournament list and the top cut of the latest
+// watched tournaments, where standings are still likely to change.
+func TakeSnapshot(spec Spec, conn ConnFunc, watched, size int) (Snapshot, error) {
+	refs, err := Tournaments(spec, conn)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snap := Snapshot{Tournaments: refs, TopCut: map[string][]Finisher{}}
+	for _, ref := range refs[:min(len(refs), watched)] {
+		body, err := conn(spec.DashboardURL(ref.Location))
+		if err != nil {
+			return Snapshot{}, err
+		}
+		d, err := spec.Decode(body)
+		if err != nil {
+			return Snapshot{}, err
+		}
+		snap.TopCut[ref.Key()] = TopCut(d, size)
+	}
+	return snap, nil
+}
+
+// Diff lists new tournaments, oldest first, then top cut changes in
+// tournaments found in both snapshots.
+func Diff(competition string, prev, next Snapshot, now time.Time) []Event {
+	known := make(map[string]bool, len(prev.Tournaments))
+	for _, t := range prev.Tournaments {
+		known[t.Key()] = true
+	}
+
+	var events []Event
+	for i := len(next.Tournaments) - 1; i >= 0; i-- {
+		t := next.Tournaments[i]
+		if known[t.Key()] {
+			continue
+		}
+		events = append(events, Event{
+			Type:        EventNewTournament,
+			Competition: competition,
+			Tournament:  t.Location,
+			Date:        t.TextDate,
+			Time:        now,
+			TopCut:      next.TopCut[t.Key()],
+		})
+	}
+
+	for _, t := range next.Tournaments {
+		cut, ok := next.TopCut[t.Key()]
+		before, seen := prev.TopCut[t.Key()]
+		if !ok || !seen || !known[t.Key()] || slices.Equal(before, cut) {
+			continue
+		}
+		entered, left := changedNames(before, cut)
+		events = append(events, Event{
+			Type:        EventTopCutChange,
+			Competition: competition,
+			Tournament:  t.Location,
+			Date:        t.TextDate,
+			Time:        now,
+			TopCut:      cut,
+			Entered:     entered,
+			Left:        left,
+		})
+	}
+	return events
+}
+
+// changedNames lists who joined and who dropped out of a top cut. Players who
+// only moved places appear in neither.
+func changedNames(before, after []Finisher) (entered, left []string) {
+	names := func(fs []Finisher) map[string]bool {
+		m := make(map[string]bool, len(fs))
+		for _, f := range fs {
+			m[f.Name] = true
+		}
+		return m
+	}
+	was, is := names(before), names(after)
+	for _, f := range after {
+		if !was[f.Name] {
+			entered = append(entered, f.Name)
+		}
+	}
+	for _, f := range before {
+		if !is[f.Name] {
+			left = append(left, f.Name)
+		}
+	}
+	return entered, left
+}
@@ -0,0 +1,111 @@
+package shell
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func standingsDecoded(names ...string) Decoded {
+	t := Table{Name: "standings", Columns: []string{"rank", "name", "points"}}
+	for i, n := range names {
+		t.Rows = append(t.Rows, []any{i + 1, n, 10})
+	}
+	return Decoded{Tables: []Table{{Name: "decks"}, t}}
+}
+
+func TestTopCut(t *testing.T) {
+	d := standingsDecoded("Ash", "Misty", "Brock")
+	assert.Equal(t, []Finisher{{1, "Ash"}, {2, "Misty"}}, TopCut(d, 2))
+	assert.Len(t, TopCut(d, 8), 3)
+	assert.Nil(t, TopCut(Decoded{}, 8))
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	spec := testSpec()
+	spec.Decode = func(body []byte) (Decoded, error) {
+		return standingsDecoded(strings.Split(string(body), ",")...), nil
+	}
+	var dashboards []string
+	conn := func(url string) ([]byte, error) {
+		if url == spec.ListURL {
+			return []byte(`[{"location":"Orlando","text_date":"May 1"},{"location":"Lima","text_date":"Apr 4"},{"location":"Oslo","text_date":"Mar 2"}]`), nil
+		}
+		dashboards = append(dashboards, url)
+		return []byte("Ash,Misty,Brock"), nil
+	}
+
+	snap, err := TakeSnapshot(spec, conn, 2, 2)
+	require.NoError(t, err)
+	assert.Len(t, snap.Tournaments, 3)
+	assert.Equal(t, map[string][]Finisher{
+		"Orlando (May 1)": {{1, "Ash"}, {2, "Misty"}},
+		"Lima (Apr 4)":    {{1, "Ash"}, {2, "Misty"}},
+	}, snap.TopCut)
+	assert.Len(t, dashboards, 2, "only the watched tournaments should be fetched")
+
+	_, err = TakeSnapshot(spec, func(string) ([]byte, error) { return nil, errors.New("offline") }, 2, 2)
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	now := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	orlando := TournamentRef{Location: "Orlando", TextDate: "May 1"}
+	lima := TournamentRef{Location: "Lima", TextDate: "Apr 4"}
+	oslo := TournamentRef{Location: "Oslo", TextDate: "Mar 2"}
+
+	prev := Snapshot{
+		Tournaments: []TournamentRef{lima},
+		TopCut:      map[string][]Finisher{lima.Key(): {{1, "Ash"}, {2, "Misty"}}},
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		assert.Empty(t, Diff("tcg", prev, prev, now))
+	})
+
+	t.Run("new tournaments oldest first", func(t *testing.T) {
+		next := Snapshot{
+			Tournaments: []TournamentRef{orlando, lima, oslo},
+			TopCut:      map[string][]Finisher{orlando.Key(): {{1, "Gary"}}, lima.Key(): prev.TopCut[lima.Key()]},
+		}
+		events := Diff("tcg", prev, next, now)
+		require.Len(t, events, 2)
+		assert.Equal(t, Event{Type: EventNewTournament, Competition: "tcg", Tournament: "Oslo", Date: "Mar 2", Time: now}, events[0])
+		assert.Equal(t, "Orlando", events[1].Tournament)
+		assert.Equal(t, []Finisher{{1, "Gary"}}, events[1].TopCut)
+	})
+
+	t.Run("top cut change", func(t *testing.T) {
+		next := Snapshot{
+			Tournaments: []TournamentRef{lima},
+			TopCut:      map[string][]Finisher{lima.Key(): {{1, "Misty"}, {2, "Brock"}}},
+		}
+		events := Diff("vgc", prev, next, now)
+		require.Len(t, events, 1)
+		e := events[0]
+		assert.Equal(t, EventTopCutChange, e.Type)
+		assert.Equal(t, []string{"Brock"}, e.Entered)
+		assert.Equal(t, []string{"Ash"}, e.Left)
+		assert.Equal(t, next.TopCut[lima.Key()], e.TopCut)
+	})
+
+	t.Run("swapped places", func(t *testing.T) {
+		next := Snapshot{
+			Tournaments: []TournamentRef{lima},
+			TopCut:      map[string][]Finisher{lima.Key(): {{1, "Misty"}, {2, "Ash"}}},
+		}
+		events := Diff("vgc", prev, next, now)
+		require.Len(t, events, 1)
+		assert.Empty(t, events[0].Entered)
+		assert.Empty(t, events[0].Left)
+	})
+
+	t.Run("untracked top cut", func(t *testing.T) {
+		next := Snapshot{Tournaments: []TournamentRef{lima}, TopCut: map[string][]Finisher{}}
+		assert.Empty(t, Diff("vgc", prev, next, now))
+	})
+}
@@ -30,7 +30,12 @@ func decode(body []byte) (shell.Decoded, error) {
 	if err := json.Unmarshal(body, &rows); err != nil {
 		return shell.Decoded{}, err
 	}
+	return build(rows), nil
+}
 
+// build lays out the dashboard for a set of standings rows, so a filter can
+// rebuild it from a subset.
+func build(rows []standingRow) shell.Decoded {
 	d := shell.Decoded{
 		TableRows: make([]table.Row, len(rows)),
 		Countries: countryItems(rows),
@@ -49,6 +54,19 @@ func decode(body []byte) (shell.Decoded, error) {
 		Items:       deckItems(rows),
 	}
 
+	d.Tables = exportTables(rows)
+	d.Placements = make([]shell.Placement, len(rows))
+	for i, r := range rows {
+		p := shell.Placement{
+			Rank:        r.Rank,
+			Country:     r.PlayerCountry,
+			CountryCode: r.CountryCode,
+			Labels:      map[string][]string{"Decks": {r.Deck}},
+		}
+		p.Wins, p.Losses, p.Ties, _ = shell.ParseRecord(r.Record)
+		d.Placements[i] = p
+	}
+
 	var tournament, tType, date, winner, winningDeck string
 	var total int
 	if len(rows) > 0 {
@@ -59,7 +77,20 @@ func decode(body []byte) (shell.Decoded, error) {
 	d.Overview = func(contentWidth int, hc color.Color) string {
 		return overviewContent(tournament, tType, date, winner, winningDeck, total, contentWidth, hc)
 	}
-	return d, nil
+	d.Filter = func(keep func(int) bool) shell.Decoded {
+		return build(filterRows(rows, keep))
+	}
+	return d
+}
+
+func filterRows(rows []standingRow, keep func(int) bool) []standingRow {
+	var out []standingRow
+	for i, r := range rows {
+		if keep(i) {
+			out = append(out, r)
+		}
+	}
+	return out
 }
 
 func countryItems(rows []standingRow) []shell.Tally {
@@ -89,3 +120,53 @@ func deckItems(rows []standingRow) []shell.Tally {
 	}
 	return items
 }
+
+func exportTables(rows []standingRow) []shell.Table {
+	standings := shell.Table{
+		Name: "standings",
+		Columns: []string{
+			"rank", "name", "points", "record", "opp_win_percent", "opp_opp_win_percent",
+			"deck", "player_country", "country_code",
+		},
+		Rows: make([][]any, len(rows)),
+	}
+	for i, r := range rows {
+		standings.Rows[i] = []any{
+			r.Rank, r.Name, r.Points, r.Record, r.OppWinPct, r.OppOppWinPct,
+			r.Deck, r.PlayerCountry, r.CountryCode,
+		}
+	}
+
+	return []shell.Table{
+		standings,
+		shell.TallyTable("decks", "deck", "players", deckItems(rows)),
+		shell.TallyTable("countries", "country", "players", countryItems(rows)),
+	}
+}
+
+func decodePlayer(body []byte) (shell.PlayerProfile, error) {
+	var rows []standingRow
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return shell.PlayerProfile{}, err
+	}
+
+	p := shell.PlayerProfile{EntryHeader: "Deck", Results: make([]shell.PlayerResult, len(rows))}
+	for i, r := range rows {
+		if p.Name == "" {
+			p.Name = r.Name
+		}
+		if p.Country == "" {
+			p.Country = r.PlayerCountry
+		}
+		p.Results[i] = shell.PlayerResult{
+			Tournament: r.Location,
+			Date:       r.TextDate,
+			Rank:       r.Rank,
+			Players:    r.PlayerQty,
+			Points:     r.Points,
+			Record:     r.Record,
+			Entry:      r.Deck,
+		}
+	}
+	return p, nil
+}
@@ -1,10 +1,13 @@
 package tcg
 
 import (
+	"slices"
 	"strings"
 	"testing"
 
 	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/styling"
 )
 
 func TestDecode_Success(t *testing.T) {
@@ -88,3 +91,106 @@ func TestSpec_URLs(t *testing.T) {
 		t.Errorf("expected URL-encoded location, got %q", durl)
 	}
 }
+
+func TestDecode_ExportTables(t *testing.T) {
+	body := []byte(`[
+		{"rank":1,"name":"Ash","points":47,"record":"15 - 1 - 0","deck":"gardevoir","player_country":"USA","country_code":"US"},
+		{"rank":2,"name":"Misty","points":44,"deck":"dragapult","player_country":"Japan","country_code":"JP"},
+		{"rank":3,"name":"Brock","points":44,"deck":"dragapult","player_country":"Japan","country_code":"JP"}
+	]`)
+	d, err := decode(body)
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+
+	var names []string
+	for _, table := range d.Tables {
+		names = append(names, table.Name)
+	}
+	if strings.Join(names, ",") != "standings,decks,countries" {
+		t.Fatalf("unexpected tables: %v", names)
+	}
+
+	standings := d.Tables[0]
+	if len(standings.Rows) != 3 || len(standings.Rows[0]) != len(standings.Columns) {
+		t.Fatalf("unexpected standings shape: %d rows, %d columns", len(standings.Rows), len(standings.Columns))
+	}
+	if standings.Rows[0][0] != 1 || standings.Rows[0][8] != "US" {
+		t.Errorf("unexpected first standings row: %v", standings.Rows[0])
+	}
+
+	if decks := d.Tables[1]; decks.Rows[0][0] != "dragapult" || decks.Rows[0][1] != 2 {
+		t.Errorf("expected the most played deck first, got %v", decks.Rows[0])
+	}
+}
+
+func TestDecode_Placements(t *testing.T) {
+	d, err := decode([]byte(`[{"rank":1,"name":"Ash","deck":"gardevoir","record":"15 - 1 - 2"},{"rank":2,"name":"Misty","deck":"dragapult"}]`))
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if len(d.Placements) != 2 || d.Placements[1].Rank != 2 || d.Placements[1].Labels["Decks"][0] != "dragapult" {
+		t.Errorf("unexpected placements: %+v", d.Placements)
+	}
+	if p := d.Placements[0]; p.Wins != 15 || p.Losses != 1 || p.Ties != 2 {
+		t.Errorf("expected the record on the placement, got %+v", p)
+	}
+	if spec := Spec(); spec.Matchups != "Decks" || !slices.Contains(spec.Tabs, shell.MatchupsTab) {
+		t.Errorf("expected a deck matchup tab, got %q in %v", spec.Matchups, spec.Tabs)
+	}
+	if cats := Spec().TrendCategories; len(cats) != 1 || cats[0] != "Decks" {
+		t.Errorf("unexpected trend categories: %v", cats)
+	}
+}
+
+func TestDecode_Filter(t *testing.T) {
+	d, err := decode([]byte(`[
+		{"rank":1,"name":"Ash","deck":"gardevoir","player_country":"USA","country_code":"US","location":"Orlando, FL"},
+		{"rank":2,"name":"Misty","deck":"dragapult","player_country":"Japan","country_code":"JP","location":"Orlando, FL"},
+		{"rank":3,"name":"Brock","deck":"dragapult","player_country":"Japan","country_code":"JP","location":"Orlando, FL"}
+	]`))
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if p := d.Placements[1]; p.Country != "Japan" || p.CountryCode != "JP" {
+		t.Errorf("expected country on placements, got %+v", p)
+	}
+
+	japan := d.Filter(func(i int) bool { return d.Placements[i].CountryCode == "JP" })
+	if len(japan.TableRows) != 2 || japan.TableRows[0][1] != "Misty" {
+		t.Errorf("unexpected filtered rows: %v", japan.TableRows)
+	}
+	if len(japan.Extra.Items) != 1 || japan.Extra.Items[0] != (shell.Tally{Label: "dragapult", Count: 2}) {
+		t.Errorf("expected decks from the kept players only, got %v", japan.Extra.Items)
+	}
+	if !strings.Contains(styling.StripANSI(japan.Overview(80, lipgloss.Color("#7D56F4"))), "Misty") {
+		t.Error("expected the overview to lead with the best kept player")
+	}
+	if japan.Filter == nil {
+		t.Error("expected filtered data to stay filterable")
+	}
+}
+
+func TestDecodePlayer(t *testing.T) {
+	if url := Spec().PlayerURL("Ash Ketchum"); !strings.Contains(url, "name=eq.Ash+Ketchum") || !strings.Contains(url, "deck") {
+		t.Errorf("unexpected player URL %q", url)
+	}
+
+	p, err := decodePlayer([]byte(`[
+		{"rank":5,"name":"Ash","points":38,"record":"12 - 3 - 1","deck":"gardevoir","player_country":"USA","location":"London","text_date":"Jan 10","player_quantity":500},
+		{"rank":1,"name":"Ash","points":47,"record":"15 - 1 - 0","deck":"dragapult","player_country":"","location":"Dallas","text_date":"Dec 2"}
+	]`))
+	if err != nil {
+		t.Fatalf("decodePlayer error: %v", err)
+	}
+	if p.Name != "Ash" || p.Country != "USA" || p.EntryHeader != "Deck" {
+		t.Errorf("unexpected profile: %+v", p)
+	}
+	if len(p.Results) != 2 || p.Results[0].Tournament != "London" || p.Results[0].Players != 500 || p.Results[1].Entry != "dragapult" {
+		t.Errorf("unexpected results: %+v", p.Results)
+	}
+
+	if _, err := decodePlayer([]byte(`{`)); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
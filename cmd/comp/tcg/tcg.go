@@ -16,7 +16,7 @@ func Run() (back bool, err error) {
 
 func Spec() shell.Spec {
 	return shell.Spec{
-		Tabs:    []string{"Overview", "Standings", "Decks", "Countries"},
+		Tabs:    []string{"Overview", "Standings", "Decks", "Countries", shell.MatchupsTab},
 		ListURL: baseURL + "?select=location,text_date&rank=eq.1&order=start_date.desc",
 		DashboardURL: func(location string) string {
 			cols := "rank,name,points,record,opp_win_percent,opp_opp_win_percent,deck,player_country,country_code,location,text_date,type,player_quantity"
@@ -24,6 +24,13 @@ func Spec() shell.Spec {
 		},
 		Columns: standingsColumns,
 		Decode:  decode,
+		PlayerURL: func(name string) string {
+			cols := "rank,name,points,record,deck,player_country,location,text_date,player_quantity"
+			return baseURL + "?select=" + cols + "&name=eq." + url.QueryEscape(name) + "&order=start_date.desc"
+		},
+		DecodePlayer:    decodePlayer,
+		TrendCategories: []string{"Decks"},
+		Matchups:        "Decks",
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"image/color"
 	"strconv"
+	"strings"
 
 	"charm.land/bubbles/v2/table"
 	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
@@ -38,7 +39,12 @@ func decode(body []byte) (shell.Decoded, error) {
 	if err := json.Unmarshal(body, &rows); err != nil {
 		return shell.Decoded{}, err
 	}
+	return build(rows), nil
+}
 
+// build lays out the dashboard for a set of standings rows, so a filter can
+// rebuild it from a subset.
+func build(rows []standingRow) shell.Decoded {
 	d := shell.Decoded{
 		TableRows: make([]table.Row, len(rows)),
 		Countries: countryItems(rows),
@@ -57,6 +63,18 @@ func decode(body []byte) (shell.Decoded, error) {
 		Items:       usageItems(rows),
 	}
 
+	d.Tables = exportTables(rows)
+	d.Sheet = func(i int) (shell.Sheet, bool) {
+		if i < 0 || i >= len(rows) || len(rows[i].Team) == 0 {
+			return shell.Sheet{}, false
+		}
+		return teamSheet(rows[i]), true
+	}
+	d.Placements = make([]shell.Placement, len(rows))
+	for i, r := range rows {
+		d.Placements[i] = placement(r)
+	}
+
 	var tournament, tType, date, winner string
 	var total int
 	var winnerTeam []string
@@ -72,7 +90,20 @@ func decode(body []byte) (shell.Decoded, error) {
 	d.Overview = func(contentWidth int, hc color.Color) string {
 		return overviewContent(tournament, tType, date, winner, winnerTeam, total, contentWidth, hc)
 	}
-	return d, nil
+	d.Filter = func(keep func(int) bool) shell.Decoded {
+		return build(filterRows(rows, keep))
+	}
+	return d
+}
+
+func filterRows(rows []standingRow, keep func(int) bool) []standingRow {
+	var out []standingRow
+	for i, r := range rows {
+		if keep(i) {
+			out = append(out, r)
+		}
+	}
+	return out
 }
 
 func countryItems(rows []standingRow) []shell.Tally {
@@ -104,3 +135,84 @@ func usageItems(rows []standingRow) []shell.Tally {
 	}
 	return items
 }
+
+func exportTables(rows []standingRow) []shell.Table {
+	standings := shell.Table{
+		Name: "standings",
+		Columns: []string{
+			"rank", "name", "points", "record", "opp_win_percent", "opp_opp_win_percent",
+			"player_country", "country_code", "team",
+		},
+		Rows: make([][]any, len(rows)),
+	}
+	// One row per team member, so sets can be compared across players
+	teams := shell.Table{
+		Name:    "teams",
+		Columns: []string{"rank", "name", "slot", "pokemon", "item", "ability", "tera_type", "moves"},
+	}
+	for i, r := range rows {
+		names := make([]string, len(r.Team))
+		for j, mon := range r.Team {
+			names[j] = mon.Name
+			moves := mon.Moves
+			if moves == nil {
+				moves = []string{}
+			}
+			teams.Rows = append(teams.Rows, []any{
+				r.Rank, r.Name, j + 1, mon.Name, mon.Item, mon.Ability, mon.TeraType, moves,
+			})
+		}
+		standings.Rows[i] = []any{
+			r.Rank, r.Name, r.Points, r.Record, r.OppWinPct, r.OppOppWinPct,
+			r.PlayerCountry, r.CountryCode, names,
+		}
+	}
+
+	return []shell.Table{
+		standings,
+		teams,
+		shell.TallyTable("usage", "pokemon", "teams", usageItems(rows)),
+		shell.TallyTable("countries", "country", "players", countryItems(rows)),
+	}
+}
+
+func placement(r standingRow) shell.Placement {
+	p := shell.Placement{Rank: r.Rank, Country: r.PlayerCountry, CountryCode: r.CountryCode, Labels: map[string][]string{}}
+	for _, mon := range r.Team {
+		p.Labels["Pokémon"] = append(p.Labels["Pokémon"], mon.Name)
+		p.Labels["Items"] = append(p.Labels["Items"], mon.Item)
+		p.Labels["Tera Types"] = append(p.Labels["Tera Types"], mon.TeraType)
+	}
+	return p
+}
+
+func decodePlayer(body []byte) (shell.PlayerProfile, error) {
+	var rows []standingRow
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return shell.PlayerProfile{}, err
+	}
+
+	p := shell.PlayerProfile{EntryHeader: "Team", Results: make([]shell.PlayerResult, len(rows))}
+	for i, r := range rows {
+		if p.Name == "" {
+			p.Name = r.Name
+		}
+		if p.Country == "" {
+			p.Country = r.PlayerCountry
+		}
+		team := make([]string, len(r.Team))
+		for j, mon := range r.Team {
+			team[j] = baseName(mon.Name)
+		}
+		p.Results[i] = shell.PlayerResult{
+			Tournament: r.Location,
+			Date:       r.TextDate,
+			Rank:       r.Rank,
+			Players:    r.PlayerQty,
+			Points:     r.Points,
+			Record:     r.Record,
+			Entry:      strings.Join(team, ", "),
+		}
+	}
+	return p, nil
+}
@@ -96,3 +96,84 @@ func TestSpec_URLs(t *testing.T) {
 		t.Errorf("expected URL-encoded location, got %q", durl)
 	}
 }
+
+func TestDecode_ExportTables(t *testing.T) {
+	body := []byte(`[
+		{"rank":1,"name":"Arsal","points":45,"player_country":"United States","country_code":"US",
+		 "team":[{"name":"Venusaur","item":"Life Orb","ability":"Chlorophyll","teratype":"Fire","badges":["Protect","Leaf Storm"]},{"name":"Iron Crown"}]}
+	]`)
+	d, err := decode(body)
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+
+	var names []string
+	for _, table := range d.Tables {
+		names = append(names, table.Name)
+	}
+	if strings.Join(names, ",") != "standings,teams,usage,countries" {
+		t.Fatalf("unexpected tables: %v", names)
+	}
+
+	standings := d.Tables[0]
+	team, ok := standings.Rows[0][8].([]string)
+	if !ok || strings.Join(team, ",") != "Venusaur,Iron Crown" {
+		t.Errorf("unexpected team column: %v", standings.Rows[0][8])
+	}
+
+	teams := d.Tables[1]
+	if len(teams.Rows) != 2 {
+		t.Fatalf("expected one teams row per Pokémon, got %d", len(teams.Rows))
+	}
+	first := teams.Rows[0]
+	if first[2] != 1 || first[3] != "Venusaur" || first[4] != "Life Orb" || first[6] != "Fire" {
+		t.Errorf("unexpected first teams row: %v", first)
+	}
+	if moves, _ := first[7].([]string); len(moves) != 2 {
+		t.Errorf("expected 2 moves, got %v", first[7])
+	}
+	if moves, ok := teams.Rows[1][7].([]string); !ok || moves == nil {
+		t.Error("expected missing moves to export as an empty list")
+	}
+}
+
+func TestDecode_Placements(t *testing.T) {
+	d, err := decode([]byte(`[{"rank":3,"name":"Arsal","team":[
+		{"name":"Venusaur","item":"Life Orb","teratype":"Fire"},
+		{"name":"Iron Crown","item":"Booster Energy","teratype":"Water"}]}]`))
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if len(d.Placements) != 1 || d.Placements[0].Rank != 3 {
+		t.Fatalf("unexpected placements: %+v", d.Placements)
+	}
+	labels := d.Placements[0].Labels
+	for _, category := range Spec().TrendCategories {
+		if len(labels[category]) != 2 {
+			t.Errorf("expected 2 %s labels, got %v", category, labels[category])
+		}
+	}
+	if labels["Items"][1] != "Booster Energy" || labels["Tera Types"][0] != "Fire" {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+}
+
+func TestDecodePlayer(t *testing.T) {
+	if url := Spec().PlayerURL("Wolfe Glick"); !strings.Contains(url, "name=eq.Wolfe+Glick") || !strings.Contains(url, "team") {
+		t.Errorf("unexpected player URL %q", url)
+	}
+
+	p, err := decodePlayer([]byte(`[
+		{"rank":2,"name":"Wolfe","points":42,"record":"8-1","player_country":"United States","location":"Indianapolis","text_date":"May 29-31, 2026",
+		 "team":[{"name":"Sneasler"},{"name":"Landorus [Therian Forme]"}]}
+	]`))
+	if err != nil {
+		t.Fatalf("decodePlayer error: %v", err)
+	}
+	if p.EntryHeader != "Team" || p.Country != "United States" || len(p.Results) != 1 {
+		t.Fatalf("unexpected profile: %+v", p)
+	}
+	if got := p.Results[0].Entry; got != "Sneasler, Landorus" {
+		t.Errorf("expected team names without forme brackets, got %q", got)
+	}
+}
@@ -0,0 +1,234 @@
+package vgc
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/cmd/move"
+	"github.com/digitalghost-dev/poke-cli/cmd/pokemon"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/imaging"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+// Lookups used by the team sheet, swapped out in tests.
+var (
+	lookupPokemon = pokemon.PokemonCommand
+	lookupMove    = move.MoveCommand
+
+	pokemonType = func(slug string) (string, error) {
+		p, _, err := connections.PokemonApiCall("pokemon", slug, connections.APIURL)
+		if err != nil {
+			return "", err
+		}
+		if len(p.Types) == 0 {
+			return "", fmt.Errorf("%s has no types", slug)
+		}
+		return p.Types[0].Type.Name, nil
+	}
+	moveType = func(slug string) (string, error) {
+		m, _, err := connections.MoveApiCall("move", slug, connections.APIURL)
+		if err != nil {
+			return "", err
+		}
+		return m.Type.Name, nil
+	}
+)
+
+// formSuffixes are dropped from forme names, so "Urshifu [Rapid Strike Style]"
+// becomes "Urshifu-Rapid-Strike" as Showdown and PokéAPI spell it.
+var formSuffixes = map[string]bool{"Forme": true, "Form": true, "Style": true, "Rider": true, "Mask": true}
+
+// showdownName converts a bracketed forme name such as "Landorus [Therian Forme]"
+// to Showdown's "Landorus-Therian".
+func showdownName(name string) string {
+	base := baseName(name)
+	open, end := strings.IndexByte(name, '['), strings.IndexByte(name, ']')
+	if open < 0 || end < open {
+		return base
+	}
+
+	parts := []string{base}
+	for _, word := range strings.Fields(name[open+1 : end]) {
+		switch {
+		case formSuffixes[word]:
+		case word == "Female":
+			parts = append(parts, "F")
+		case word == "Male":
+			parts = append(parts, "M")
+		default:
+			parts = append(parts, word)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// slug turns a display name into a PokéAPI name, e.g. "King's Shield" to "kings-shield".
+func slug(name string) string {
+	s := strings.ToLower(strings.TrimSpace(name))
+	s = strings.NewReplacer("'", "", "’", "", ".", "", ":", "", " ", "-").Replace(s)
+	return s
+}
+
+// showdownPaste formats a team in Showdown's import format.
+func showdownPaste(team []vgcMon) string {
+	sets := make([]string, 0, len(team))
+	for _, mon := range team {
+		var b strings.Builder
+		b.WriteString(showdownName(mon.Name))
+		if mon.Item != "" {
+			b.WriteString(" @ " + mon.Item)
+		}
+		b.WriteString("\n")
+		if mon.Ability != "" {
+			b.WriteString("Ability: " + mon.Ability + "\n")
+		}
+		if mon.TeraType != "" {
+			b.WriteString("Tera Type: " + mon.TeraType + "\n")
+		}
+		for _, m := range mon.Moves {
+			b.WriteString("- " + m + "\n")
+		}
+		sets = append(sets, b.String())
+	}
+	return strings.Join(sets, "\n")
+}
+
+func pokemonLink(mon vgcMon) shell.Link {
+	name := slug(showdownName(mon.Name))
+	fallback := slug(baseName(mon.Name))
+	return shell.Link{
+		Label: mon.Name,
+		Lookup: func() (string, error) {
+			out, err := lookupPokemon([]string{"pokemon", name})
+			if err != nil && fallback != name {
+				return lookupPokemon([]string{"pokemon", fallback})
+			}
+			return out, err
+		},
+		Type: func() (string, error) {
+			t, err := pokemonType(name)
+			if err != nil && fallback != name {
+				return pokemonType(fallback)
+			}
+			return t, err
+		},
+	}
+}
+
+func moveLink(m string) shell.Link {
+	name := slug(m)
+	return shell.Link{
+		Label:  m,
+		Lookup: func() (string, error) { return lookupMove([]string{"move", name}) },
+		Type:   func() (string, error) { return moveType(name) },
+	}
+}
+
+// teamSheet builds the detail sheet for a standings row. Links run through each
+// Pokémon followed by its moves, in team order.
+func teamSheet(r standingRow) shell.Sheet {
+	var links []shell.Link
+	for _, mon := range r.Team {
+		links = append(links, pokemonLink(mon))
+		for _, m := range mon.Moves {
+			links = append(links, moveLink(m))
+		}
+	}
+
+	title := fmt.Sprintf("#%d %s", r.Rank, r.Name)
+	if r.PlayerCountry != "" {
+		title += " · " + r.PlayerCountry
+	}
+
+	team := r.Team
+	return shell.Sheet{
+		Title:    title,
+		Links:    links,
+		Paste:    showdownPaste(team),
+		FileName: imaging.FileName(r.Name) + "-team.txt",
+		Render: func(width, cursor int, types map[string]string) string {
+			return renderSheet(team, width, cursor, types)
+		},
+	}
+}
+
+func typeStyle(typeName string) lipgloss.Style {
+	c := styling.GetTypeColor(strings.ToLower(typeName))
+	if c == "" {
+		return lipgloss.NewStyle()
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(c))
+}
+
+func renderSheet(team []vgcMon, width, cursor int, types map[string]string) string {
+	if len(team) == 0 {
+		return "No team sheet for this player."
+	}
+
+	const perRow = 3
+	cardWidth := min(max((width-perRow*2)/perRow, 24), 34)
+	selected := lipgloss.NewStyle().
+		Foreground(styling.ContrastText(styling.ThemeColor)).
+		Background(styling.ThemeColor)
+
+	link := 0
+	label := func(text string, style lipgloss.Style) string {
+		defer func() { link++ }()
+		if link == cursor {
+			return selected.Render(text)
+		}
+		return style.Render(text)
+	}
+
+	cardLines := make([][]string, len(team))
+	height := 0
+	for i, mon := range team {
+		lines := []string{label(mon.Name, typeStyle(types[mon.Name]).Bold(true))}
+		if mon.Item != "" {
+			lines = append(lines, "@ "+mon.Item)
+		}
+		if mon.Ability != "" {
+			lines = append(lines, "Ability: "+mon.Ability)
+		}
+		if mon.TeraType != "" {
+			lines = append(lines, "Tera: "+typeStyle(mon.TeraType).Render(mon.TeraType))
+		}
+		if len(mon.Moves) > 0 {
+			lines = append(lines, "")
+		}
+		for _, m := range mon.Moves {
+			lines = append(lines, "- "+label(m, typeStyle(types[m])))
+		}
+		cardLines[i] = lines
+		height = max(height, len(lines))
+	}
+
+	// Pad every card to the same height so the grid lines up
+	cards := make([]string, len(team))
+	for i, mon := range team {
+		border := styling.Gray
+		if t, ok := types[mon.Name]; ok {
+			border = lipgloss.Color(styling.GetTypeColor(t))
+		}
+		lines := cardLines[i]
+		for len(lines) < height {
+			lines = append(lines, "")
+		}
+
+		cards[i] = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(border).
+			Padding(0, 1).
+			Width(cardWidth).
+			Render(strings.Join(lines, "\n"))
+	}
+
+	var rows []string
+	for i := 0; i < len(cards); i += perRow {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cards[i:min(i+perRow, len(cards))]...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
@@ -0,0 +1,133 @@
+package vgc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+func testTeam() []vgcMon {
+	return []vgcMon{
+		{Name: "Landorus [Therian Forme]", Item: "Choice Scarf", Ability: "Intimidate", TeraType: "Steel", Moves: []string{"Stomping Tantrum", "U-turn"}},
+		{Name: "Aegislash", Moves: []string{"King's Shield"}},
+	}
+}
+
+func TestShowdownName(t *testing.T) {
+	tests := map[string]string{
+		"Incineroar":                   "Incineroar",
+		"Landorus [Therian Forme]":     "Landorus-Therian",
+		"Urshifu [Rapid Strike Style]": "Urshifu-Rapid-Strike",
+		"Calyrex [Shadow Rider]":       "Calyrex-Shadow",
+		"Ogerpon [Hearthflame Mask]":   "Ogerpon-Hearthflame",
+		"Indeedee [Female]":            "Indeedee-F",
+	}
+	for in, want := range tests {
+		if got := showdownName(in); got != want {
+			t.Errorf("showdownName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := map[string]string{
+		"King's Shield":    "kings-shield",
+		"U-turn":           "u-turn",
+		"Landorus-Therian": "landorus-therian",
+		"Mr. Mime":         "mr-mime",
+		"Type: Null":       "type-null",
+	}
+	for in, want := range tests {
+		if got := slug(in); got != want {
+			t.Errorf("slug(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestShowdownPaste(t *testing.T) {
+	want := "Landorus-Therian @ Choice Scarf\n" +
+		"Ability: Intimidate\n" +
+		"Tera Type: Steel\n" +
+		"- Stomping Tantrum\n" +
+		"- U-turn\n" +
+		"\n" +
+		"Aegislash\n" +
+		"- King's Shield\n"
+	if got := showdownPaste(testTeam()); got != want {
+		t.Errorf("unexpected paste:\n%s", got)
+	}
+}
+
+func TestTeamSheet(t *testing.T) {
+	origPokemon, origMove, origPokemonType, origMoveType := lookupPokemon, lookupMove, pokemonType, moveType
+	defer func() {
+		lookupPokemon, lookupMove, pokemonType, moveType = origPokemon, origMove, origPokemonType, origMoveType
+	}()
+
+	var looked []string
+	lookupPokemon = func(args []string) (string, error) {
+		looked = append(looked, args[1])
+		if args[1] == "landorus-therian" {
+			return "", errors.New("not found")
+		}
+		return "POKEMON " + args[1], nil
+	}
+	lookupMove = func(args []string) (string, error) { return "MOVE " + args[1], nil }
+	pokemonType = func(slug string) (string, error) { return "ground", nil }
+	moveType = func(slug string) (string, error) { return "", errors.New("offline") }
+
+	sheet := teamSheet(standingRow{Rank: 2, Name: "Wolfe Glick", PlayerCountry: "United States", Team: testTeam()})
+
+	if sheet.Title != "#2 Wolfe Glick · United States" {
+		t.Errorf("unexpected title %q", sheet.Title)
+	}
+	if sheet.FileName != "Wolfe_Glick-team.txt" {
+		t.Errorf("unexpected file name %q", sheet.FileName)
+	}
+
+	var labels []string
+	for _, l := range sheet.Links {
+		labels = append(labels, l.Label)
+	}
+	if got := strings.Join(labels, ","); got != "Landorus [Therian Forme],Stomping Tantrum,U-turn,Aegislash,King's Shield" {
+		t.Errorf("unexpected links: %s", got)
+	}
+
+	out, err := sheet.Links[0].Lookup()
+	if err != nil || out != "POKEMON landorus" {
+		t.Errorf("expected the base name fallback, got %q, %v", out, err)
+	}
+	if strings.Join(looked, ",") != "landorus-therian,landorus" {
+		t.Errorf("unexpected lookups: %v", looked)
+	}
+	if out, _ := sheet.Links[4].Lookup(); out != "MOVE kings-shield" {
+		t.Errorf("unexpected move lookup %q", out)
+	}
+	if typ, _ := sheet.Links[0].Type(); typ != "ground" {
+		t.Errorf("unexpected type %q", typ)
+	}
+
+	view := styling.StripANSI(sheet.Render(110, 1, map[string]string{"Aegislash": "steel"}))
+	for _, want := range []string{"Landorus [Therian Forme]", "@ Choice Scarf", "Ability: Intimidate", "Tera: Steel", "- U-turn", "- King's Shield"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected sheet to contain %q", want)
+		}
+	}
+}
+
+func TestDecode_Sheet(t *testing.T) {
+	d, err := decode([]byte(`[{"rank":1,"name":"Arsal","team":[{"name":"Venusaur"}]},{"rank":2,"name":"Wolfe","team":[]}]`))
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if _, ok := d.Sheet(0); !ok {
+		t.Error("expected a sheet for a player with a team")
+	}
+	for _, i := range []int{1, -1, 5} {
+		if _, ok := d.Sheet(i); ok {
+			t.Errorf("expected no sheet for row %d", i)
+		}
+	}
+}
@@ -24,6 +24,12 @@ func Spec() shell.Spec {
 		},
 		Columns: standingsColumns,
 		Decode:  decode,
+		PlayerURL: func(name string) string {
+			cols := "rank,name,points,record,team,player_country,location,text_date,player_quantity"
+			return baseURL + "?select=" + cols + "&name=eq." + url.QueryEscape(name) + "&order=start_date.desc"
+		},
+		DecodePlayer:    decodePlayer,
+		TrendCategories: []string{"Pokémon", "Items", "Tera Types"},
 	}
 }
 
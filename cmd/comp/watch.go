@@ -0,0 +1,242 @@
+package comp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/tcg"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/vgc"
+	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// watchedTournaments is how many of the latest tournaments have their top cut tracked.
+	watchedTournaments = 3
+	minWatchInterval   = time.Minute
+	watchStateFile     = "comp-watch.json"
+)
+
+// Swapped out in tests.
+var (
+	watchConn   shell.ConnFunc = connections.CallTCGData
+	watchOut    io.Writer      = os.Stdout
+	watchErr    io.Writer      = os.Stderr
+	watchConfig                = flags.Load
+	watchState                 = func() (string, error) { return flags.StatePath(watchStateFile) }
+	watchNow                   = time.Now
+)
+
+var watchSpecs = map[string]func() shell.Spec{
+	"tcg": tcg.Spec,
+	"vgc": vgc.Spec,
+}
+
+// watchSource is where a watched competition's data comes from.
+type watchSource struct {
+	spec shell.Spec
+	conn shell.ConnFunc
+}
+
+// watchCommand handles 'poke-cli comp watch [flags]'
+func watchCommand(args []string) (string, error) {
+	var output strings.Builder
+
+	fail := func(msg string) (string, error) {
+		err := fmt.Errorf("%s", utils.FormatError(msg))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	wf := flags.SetupCompWatchFlagSet()
+	if err := wf.FlagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("comp", err))
+		return output.String(), err
+	}
+
+	if wf.FlagSet.NArg() > 0 {
+		return fail(fmt.Sprintf("Unexpected argument %q\nUse --comp to pick competitions, e.g. --comp=vgc", wf.FlagSet.Arg(0)))
+	}
+	sources := make(map[string]watchSource, len(*wf.Comp))
+	for _, c := range *wf.Comp {
+		if spec, ok := watchSpecs[c]; ok {
+			sources[c] = watchSource{spec: spec(), conn: watchConn}
+			continue
+		}
+		p, ok, err := findPlugin(c)
+		if err != nil {
+			return fail(fmt.Sprintf("Could not load comp plugins: %v", err))
+		}
+		if !ok {
+			return fail(fmt.Sprintf("Cannot watch %q\nThe only available options are tcg and vgc,\nor the ID of a comp plugin", c))
+		}
+		sources[c] = watchSource{spec: p.Spec, conn: p.Conn}
+	}
+	if *wf.Interval < minWatchInterval {
+		return fail(fmt.Sprintf("--interval must be at least %s.", minWatchInterval))
+	}
+	if *wf.Top < 1 {
+		return fail("--top must be at least 1.")
+	}
+
+	cfg, _, err := watchConfig()
+	if err != nil {
+		return fail(fmt.Sprintf("Could not load the config: %v", err))
+	}
+	statePath, err := watchState()
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	w := watcher{comps: *wf.Comp, sources: sources, top: *wf.Top, notify: cfg.Comp.NotifyCommand, statePath: statePath}
+	for {
+		if err := w.poll(ctx); err != nil {
+			if *wf.Once {
+				return fail(err.Error())
+			}
+			// A failed poll is retried on the next interval
+			fmt.Fprintf(watchErr, "comp watch: %v\n", err)
+		}
+		if *wf.Once {
+			return output.String(), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return output.String(), nil
+		case <-time.After(*wf.Interval):
+		}
+	}
+}
+
+type watcher struct {
+	comps     []string
+	sources   map[string]watchSource
+	top       int
+	notify    []string
+	statePath string
+}
+
+// poll takes a snapshot of each competition, emits what changed since the
+// last one and saves the new snapshots. A competition seen for the first time
+// only records its snapshot.
+func (w watcher) poll(ctx context.Context) error {
+	state, err := loadWatchState(w.statePath)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, comp := range w.comps {
+		src := w.sources[comp]
+		next, err := shell.TakeSnapshot(src.spec, src.conn, watchedTournaments, w.top)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", comp, err))
+			continue
+		}
+		if prev, ok := state[comp]; ok {
+			for _, e := range shell.Diff(comp, prev, next, watchNow().UTC()) {
+				w.emit(ctx, e)
+			}
+		}
+		state[comp] = next
+	}
+
+	if err := saveWatchState(w.statePath, state); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// emit writes the event as a JSON line and runs the notify command, if any.
+func (w watcher) emit(ctx context.Context, e shell.Event) {
+	line, err := json.Marshal(e)
+	if err != nil {
+		fmt.Fprintf(watchErr, "comp watch: %v\n", err)
+		return
+	}
+	fmt.Fprintf(watchOut, "%s\n", line)
+
+	if len(w.notify) == 0 {
+		return
+	}
+	title, message := describeEvent(e)
+	args := append(append([]string{}, w.notify[1:]...), title, message)
+	cmd := exec.CommandContext(ctx, w.notify[0], args...) // #nosec G204
+	cmd.Stdin = bytes.NewReader(append(line, '\n'))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		fmt.Fprintf(watchErr, "comp watch: notify command failed: %v %s\n", err, strings.TrimSpace(string(out)))
+	}
+}
+
+// describeEvent is the notification title and message for an event.
+func describeEvent(e shell.Event) (string, string) {
+	comp := strings.ToUpper(e.Competition)
+	switch e.Type {
+	case shell.EventNewTournament:
+		return fmt.Sprintf("New %s tournament", comp), fmt.Sprintf("%s, %s", e.Tournament, e.Date)
+	default:
+		var parts []string
+		if len(e.Entered) > 0 {
+			parts = append(parts, "In: "+strings.Join(e.Entered, ", "))
+		}
+		if len(e.Left) > 0 {
+			parts = append(parts, "Out: "+strings.Join(e.Left, ", "))
+		}
+		if len(parts) == 0 {
+			parts = append(parts, "Placings changed")
+		}
+		if len(e.TopCut) > 0 {
+			parts = append(parts, "Leader: "+e.TopCut[0].Name)
+		}
+		return fmt.Sprintf("%s top cut changed: %s", comp, e.Tournament), strings.Join(parts, ". ")
+	}
+}
+
+// loadWatchState reads the last snapshot of each competition. A missing file
+// means nothing has been watched yet.
+func loadWatchState(path string) (map[string]shell.Snapshot, error) {
+	state := map[string]shell.Snapshot{}
+	data, err := os.ReadFile(path) // #nosec G304
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", path, err)
+	}
+	return state, nil
+}
+
+func saveWatchState(path string, state map[string]shell.Snapshot) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
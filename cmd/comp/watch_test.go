@@ -0,0 +1,219 @@
+package comp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// watchStub serves a tournament list and TCG standings that tests can change between polls.
+type watchStub struct {
+	list      string
+	standings string
+	err       error
+}
+
+func stubWatch(t *testing.T, cfg flags.Config) (*watchStub, *bytes.Buffer, *bytes.Buffer, string) {
+	t.Helper()
+	origConn, origOut, origErr, origConfig, origState, origNow := watchConn, watchOut, watchErr, watchConfig, watchState, watchNow
+	t.Cleanup(func() {
+		watchConn, watchOut, watchErr, watchConfig, watchState, watchNow = origConn, origOut, origErr, origConfig, origState, origNow
+	})
+
+	stub := &watchStub{
+		list:      `[{"location":"Orlando, FL","text_date":"May 1-3, 2026"}]`,
+		standings: `[{"rank":1,"name":"Ash"},{"rank":2,"name":"Misty"}]`,
+	}
+	var out, errOut bytes.Buffer
+	statePath := filepath.Join(t.TempDir(), "poke-cli", "comp-watch.json")
+
+	watchConn = func(url string) ([]byte, error) {
+		switch {
+		case stub.err != nil:
+			return nil, stub.err
+		case strings.Contains(url, "rank=eq.1"):
+			return []byte(stub.list), nil
+		default:
+			return []byte(stub.standings), nil
+		}
+	}
+	watchOut, watchErr = &out, &errOut
+	watchConfig = func() (flags.Config, bool, error) { return cfg, false, nil }
+	watchState = func() (string, error) { return statePath, nil }
+	watchNow = func() time.Time { return time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC) }
+	return stub, &out, &errOut, statePath
+}
+
+func watchEvents(t *testing.T, out *bytes.Buffer) []shell.Event {
+	t.Helper()
+	var events []shell.Event
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var e shell.Event
+		require.NoError(t, json.Unmarshal([]byte(line), &e), line)
+		events = append(events, e)
+	}
+	out.Reset()
+	return events
+}
+
+func TestCompWatch_Events(t *testing.T) {
+	stub, out, _, statePath := stubWatch(t, flags.Defaults())
+	args := []string{"comp", "watch", "--comp=tcg", "--once"}
+
+	// The first poll only records a snapshot
+	output, err := CompCommand(args)
+	require.NoError(t, err, output)
+	assert.Empty(t, watchEvents(t, out))
+	assert.FileExists(t, statePath)
+
+	output, err = CompCommand(args)
+	require.NoError(t, err, output)
+	assert.Empty(t, watchEvents(t, out), "an unchanged poll should be quiet")
+
+	stub.list = `[{"location":"Lima","text_date":"May 8-10, 2026"},{"location":"Orlando, FL","text_date":"May 1-3, 2026"}]`
+	stub.standings = `[{"rank":1,"name":"Brock"},{"rank":2,"name":"Ash"}]`
+	output, err = CompCommand(args)
+	require.NoError(t, err, output)
+
+	events := watchEvents(t, out)
+	require.Len(t, events, 2)
+	assert.Equal(t, shell.EventNewTournament, events[0].Type)
+	assert.Equal(t, "Lima", events[0].Tournament)
+	assert.Equal(t, "tcg", events[0].Competition)
+	assert.Equal(t, shell.EventTopCutChange, events[1].Type)
+	assert.Equal(t, "Orlando, FL", events[1].Tournament)
+	assert.Equal(t, []string{"Brock"}, events[1].Entered)
+	assert.Equal(t, []string{"Misty"}, events[1].Left)
+	assert.Equal(t, time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC), events[1].Time)
+}
+
+func TestCompWatch_PollError(t *testing.T) {
+	stub, _, _, _ := stubWatch(t, flags.Defaults())
+	stub.err = errors.New("offline")
+
+	output, err := CompCommand([]string{"comp", "watch", "--once"})
+	require.Error(t, err)
+	clean := styling.StripANSI(output)
+	assert.Contains(t, clean, "tcg: offline")
+	assert.Contains(t, clean, "vgc: offline")
+}
+
+func TestCompWatch_CorruptState(t *testing.T) {
+	_, _, _, statePath := stubWatch(t, flags.Defaults())
+	require.NoError(t, os.MkdirAll(filepath.Dir(statePath), 0o750))
+	require.NoError(t, os.WriteFile(statePath, []byte("{"), 0o600))
+
+	output, err := CompCommand([]string{"comp", "watch", "--once"})
+	require.Error(t, err)
+	assert.Contains(t, styling.StripANSI(output), "could not read")
+}
+
+func TestCompWatch_Notify(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("notify script uses sh")
+	}
+
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "notify.log")
+	script := filepath.Join(dir, "notify.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\nprintf '%s|%s|%s\\n' \"$1\" \"$2\" \"$3\" >> \""+logPath+"\"\ncat >> \""+logPath+"\"\n"), 0o700))
+
+	cfg := flags.Defaults()
+	cfg.Comp.NotifyCommand = []string{script, "--urgent"}
+	stub, out, _, _ := stubWatch(t, cfg)
+	args := []string{"comp", "watch", "-c", "tcg", "--once"}
+
+	_, err := CompCommand(args)
+	require.NoError(t, err)
+	stub.standings = `[{"rank":1,"name":"Misty"},{"rank":2,"name":"Brock"}]`
+	_, err = CompCommand(args)
+	require.NoError(t, err)
+	require.Len(t, watchEvents(t, out), 1)
+
+	log, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(log)), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "--urgent|TCG top cut changed: Orlando, FL|In: Brock. Out: Ash. Leader: Misty", lines[0])
+	assert.Contains(t, lines[1], `"type":"top_cut_change"`)
+}
+
+func TestCompWatch_NotifyFailure(t *testing.T) {
+	cfg := flags.Defaults()
+	cfg.Comp.NotifyCommand = []string{filepath.Join(t.TempDir(), "missing")}
+	stub, out, errOut, _ := stubWatch(t, cfg)
+	args := []string{"comp", "watch", "-c", "tcg", "--once"}
+
+	_, err := CompCommand(args)
+	require.NoError(t, err)
+	stub.standings = `[{"rank":1,"name":"Misty"}]`
+	_, err = CompCommand(args)
+	require.NoError(t, err, "a failed notify command should not stop watching")
+
+	assert.Len(t, watchEvents(t, out), 1)
+	assert.Contains(t, errOut.String(), "notify command failed")
+}
+
+func TestCompWatch_Plugin(t *testing.T) {
+	stub, out, _, _ := stubWatch(t, flags.Defaults())
+	stub.err = errors.New("the built-in source should not be called")
+	stubPlugins(t)
+	args := []string{"comp", "watch", "--comp=league", "--once"}
+
+	output, err := CompCommand(args)
+	require.NoError(t, err, output)
+	assert.Empty(t, watchEvents(t, out))
+
+	// Same standings, no events
+	output, err = CompCommand(args)
+	require.NoError(t, err, output)
+	assert.Empty(t, watchEvents(t, out))
+}
+
+func TestCompWatch_InvalidFlags(t *testing.T) {
+	stubWatch(t, flags.Defaults())
+	stubPlugins(t)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"comp", "watch", "extra"}, `Unexpected argument "extra"`},
+		{[]string{"comp", "watch", "--comp=champions"}, `Cannot watch "champions"`},
+		{[]string{"comp", "watch", "--comp=tcg,kanto"}, `Cannot watch "kanto"`},
+		{[]string{"comp", "watch", "--interval=10s"}, "--interval must be at least 1m0s."},
+		{[]string{"comp", "watch", "--top=0"}, "--top must be at least 1."},
+		{[]string{"comp", "watch", "--bogus"}, "bogus"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			output, err := CompCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.want)
+		})
+	}
+}
+
+func TestDescribeEvent(t *testing.T) {
+	title, message := describeEvent(shell.Event{Type: shell.EventNewTournament, Competition: "vgc", Tournament: "Lima", Date: "May 8-10, 2026"})
+	assert.Equal(t, "New VGC tournament", title)
+	assert.Equal(t, "Lima, May 8-10, 2026", message)
+
+	_, message = describeEvent(shell.Event{Type: shell.EventTopCutChange, Competition: "vgc", Tournament: "Lima"})
+	assert.Equal(t, "Placings changed", message)
+}
@@ -1,6 +1,7 @@
 package item
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -8,8 +9,10 @@ import (
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
 	"github.com/digitalghost-dev/poke-cli/connections"
 	"github.com/digitalghost-dev/poke-cli/constants"
+	"github.com/digitalghost-dev/poke-cli/flags"
 	"github.com/digitalghost-dev/poke-cli/structs"
 	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
 )
 
 func ItemCommand(args []string) (string, error) {
@@ -23,18 +26,25 @@ func ItemCommand(args []string) (string, error) {
 					CmdName:        "item",
 					SubCmdName:     "<item-name>",
 					ShowHyphenHint: true,
+					Flags: []utils.FlagHelp{
+						{Short: "-i", Long: "--image", Description: "Prints the item's sprite."},
+						{Short: "-m", Long: "--machine", Description: "Prints the move a TM, HM or TR teaches in each game."},
+						{Short: "-p", Long: "--pokemon", Description: "Prints the wild Pokémon that hold the item in each game."},
+					},
 				},
 			),
 		)
 	}
 
+	itf := flags.SetupItemFlagSet()
+
 	if utils.CheckHelpFlag(args, usage) {
 		return output.String(), nil
 	}
 
 	if err := utils.ValidateArgs(
 		args,
-		utils.Validator{MaxArgs: 2, CmdName: "item", RequireName: true, HasFlags: false},
+		utils.Validator{MaxArgs: 5, CmdName: "item", RequireName: true, HasFlags: true},
 	); err != nil {
 		output.WriteString(err.Error())
 		return output.String(), err
@@ -43,6 +53,14 @@ func ItemCommand(args []string) (string, error) {
 	endpoint := strings.ToLower(args[0])
 	itemName := strings.ToLower(args[1])
 
+	if err := itf.FlagSet.Parse(args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("item", err))
+		return output.String(), err
+	}
+
 	itemStruct, itemName, err := connections.ItemApiCall(endpoint, itemName, connections.APIURL)
 	if err != nil {
 		output.WriteString(err.Error())
@@ -51,6 +69,24 @@ func ItemCommand(args []string) (string, error) {
 
 	itemInfoContainer(&output, itemStruct, itemName)
 
+	// Flags print in the order of the help menu
+	for _, f := range []struct {
+		set bool
+		run func() error
+	}{
+		{*itf.Image, func() error { return flags.ItemImageFlag(&output, endpoint, itemName) }},
+		{*itf.Machine, func() error { return flags.MachineFlag(&output, endpoint, itemName) }},
+		{*itf.Pokemon, func() error { return flags.HeldByFlag(&output, endpoint, itemName) }},
+	} {
+		if !f.set {
+			continue
+		}
+		output.WriteString("\n")
+		if err := f.run(); err != nil {
+			return utils.HandleFlagError(&output, err)
+		}
+	}
+
 	return output.String(), nil
 }
 
@@ -67,25 +103,40 @@ func itemInfoContainer(output *strings.Builder, itemStruct structs.ItemJSONStruc
 		BorderForeground(ld(lipgloss.Color("#444"), lipgloss.Color("#EEE"))).
 		Width(34)
 
+	lines := []string{capitalizedItem, itemCost, itemCategory}
+	if itemStruct.FlingPower > 0 {
+		lines = append(lines, fmt.Sprintf("Fling Power: %d", itemStruct.FlingPower))
+	}
+	if itemStruct.FlingEffect.Name != "" {
+		lines = append(lines, "Fling Effect: "+styling.CapitalizeResourceName(itemStruct.FlingEffect.Name))
+	}
+	if len(itemStruct.Attributes) > 0 {
+		attributes := make([]string, len(itemStruct.Attributes))
+		for i, a := range itemStruct.Attributes {
+			attributes[i] = styling.CapitalizeResourceName(a.Name)
+		}
+		lines = append(lines, "Attributes: "+strings.Join(attributes, ", "))
+	}
+
+	for _, entry := range itemStruct.EffectEntries {
+		if entry.Language.Name == "en" && entry.ShortEffect != "" {
+			lines = append(lines, "---", "Effect:", entry.ShortEffect)
+			break
+		}
+	}
+
 	var flavorTextEntry string
-	var missingData string
-	var fullDoc string
-
-	if len(itemStruct.FlavorTextEntries) == 0 {
-		missingData = styling.StyleItalic.Render("Missing data from API")
-		fullDoc = lipgloss.JoinVertical(lipgloss.Top, capitalizedItem, itemCost, itemCategory, "---", "Description:", missingData)
-	} else {
-		for _, entry := range itemStruct.FlavorTextEntries {
-			if entry.Language.Name == "en" && entry.VersionGroup.Name == constants.VersionSwordShield {
-				if entry.Text != "" {
-					flavorTextEntry = entry.Text
-					fullDoc = lipgloss.JoinVertical(lipgloss.Top, capitalizedItem, itemCost, itemCategory, "---", "Description:", flavorTextEntry)
-					break
-				}
-			}
+	for _, entry := range itemStruct.FlavorTextEntries {
+		if entry.Language.Name == "en" && entry.VersionGroup.Name == constants.VersionSwordShield && entry.Text != "" {
+			flavorTextEntry = entry.Text
+			break
 		}
 	}
+	if flavorTextEntry == "" {
+		flavorTextEntry = styling.StyleItalic.Render("Missing data from API")
+	}
+	lines = append(lines, "---", "Description:", flavorTextEntry)
 
-	output.WriteString(docStyle.Render(fullDoc))
+	output.WriteString(docStyle.Render(lipgloss.JoinVertical(lipgloss.Top, lines...)))
 	output.WriteString("\n")
 }
@@ -1,10 +1,13 @@
 package item
 
 import (
+	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/structs"
 	"github.com/digitalghost-dev/poke-cli/styling"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -51,7 +54,7 @@ func TestItemCommand(t *testing.T) {
 		},
 		{
 			name:           "Too many arguments",
-			args:           []string{"item", "dubious-disc", "--help"},
+			args:           []string{"item", "dubious-disc", "-i", "-m", "-p", "--help"},
 			expectedOutput: utils.LoadGolden(t, "item_too_many_args.golden"),
 			expectedError:  true,
 		},
@@ -71,3 +74,48 @@ func TestItemCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestItemInfoContainer(t *testing.T) {
+	var itemStruct structs.ItemJSONStruct
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "toxic-orb",
+		"cost": 4000,
+		"category": {"name": "bad-held-items"},
+		"fling_power": 30,
+		"fling_effect": {"name": "badly-poison"},
+		"attributes": [{"name": "holdable"}, {"name": "holdable-active"}],
+		"effect_entries": [{"short_effect": "Badly poisons the holder at the end of each turn.", "language": {"name": "en"}}],
+		"flavor_text_entries": [{"text": "An orb that poisons its holder.", "language": {"name": "en"}, "version_group": {"name": "sword-shield"}}]
+	}`), &itemStruct))
+
+	var output strings.Builder
+	itemInfoContainer(&output, itemStruct, "toxic-orb")
+	// Drop the border and line wrapping to match whole sentences
+	out := strings.Join(strings.Fields(strings.ReplaceAll(styling.StripANSI(output.String()), "┃", "")), " ")
+
+	assert.Contains(t, out, "Toxic Orb")
+	assert.Contains(t, out, "Fling Power: 30")
+	assert.Contains(t, out, "Fling Effect: Badly Poison")
+	assert.Contains(t, out, "Attributes: Holdable, Holdable Active")
+	assert.Contains(t, out, "Effect: Badly poisons the holder at the end of each turn.")
+	assert.Contains(t, out, "An orb that poisons its holder.")
+}
+
+func TestItemInfoContainer_MissingData(t *testing.T) {
+	var itemStruct structs.ItemJSONStruct
+	itemStruct.Name = "clear-amulet"
+
+	var output strings.Builder
+	itemInfoContainer(&output, itemStruct, "clear-amulet")
+	out := styling.StripANSI(output.String())
+
+	assert.Contains(t, out, "Missing data from API")
+	assert.NotContains(t, out, "Fling")
+	assert.NotContains(t, out, "Effect:")
+}
+
+func TestItemCommand_InvalidFlag(t *testing.T) {
+	output, err := ItemCommand([]string{"item", "choice-band", "--bogus"})
+	require.Error(t, err)
+	assert.Contains(t, styling.StripANSI(output), "Invalid flag for item")
+}
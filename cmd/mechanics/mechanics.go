@@ -2,6 +2,7 @@ package mechanics
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
@@ -20,6 +21,17 @@ func MechanicsCommand(args []string) (string, error) {
 					CmdName:     "mechanics",
 					Flags: []utils.FlagHelp{
 						{Short: "-n", Long: "--natures", Description: "Prints a table with all natures and their respective buffs and debuffs."},
+						{Short: "-s", Long: "--stages", Description: "Prints the stat stage multipliers."},
+						{Short: "-a", Long: "--accuracy", Description: "Prints the accuracy and evasion stage multipliers."},
+						{Short: "-c", Long: "--crit", Description: "Prints the critical hit chance of each stage by generation."},
+						{Short: "-w", Long: "--weather", Description: "Prints the effects of each weather."},
+						{Short: "-t", Long: "--terrain", Description: "Prints the effects of each terrain."},
+						{Short: "-S", Long: "--status", Description: "Prints the effects of status conditions."},
+						{Short: "-e", Long: "--exp", Description: "Prints the EXP needed per level for each growth rate."},
+						{Short: "-f", Long: "--format", Description: "Output format: table, json or csv. Defaults to table."},
+						{Short: "", Long: "--for", Description: "With --natures, suggests natures for a Pokémon from its stats and usage."},
+						{Short: "-b", Long: "--boost", Description: "With --natures, only natures that raise this stat."},
+						{Short: "-l", Long: "--lower", Description: "With --natures, only natures that lower this stat."},
 					},
 				},
 			),
@@ -34,7 +46,7 @@ func MechanicsCommand(args []string) (string, error) {
 
 	if err := utils.ValidateArgs(
 		args,
-		utils.Validator{MaxArgs: 2, CmdName: "mechanics", RequireName: false, HasFlags: true},
+		utils.Validator{MaxArgs: 20, CmdName: "mechanics", RequireName: false, HasFlags: true},
 	); err != nil {
 		output.WriteString(err.Error())
 		return output.String(), err
@@ -48,11 +60,101 @@ func MechanicsCommand(args []string) (string, error) {
 		return output.String(), err
 	}
 
+	fail := func(msg string) (string, error) {
+		err := fmt.Errorf("%s", utils.FormatError(msg))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	if mf.FlagSet.NArg() > 0 {
+		return fail("Too many arguments")
+	}
+
+	format := strings.ToLower(*mf.Format)
+	if format != "table" && format != "json" && format != "csv" {
+		return fail("--format must be table, json or csv.")
+	}
+
+	var boost, lower string
+	for _, stat := range []struct {
+		value string
+		dest  *string
+	}{{*mf.Boost, &boost}, {*mf.Lower, &lower}} {
+		if stat.value == "" {
+			continue
+		}
+		parsed, ok := parseStat(stat.value)
+		if !ok {
+			return fail(fmt.Sprintf("Unknown stat %q\nChoose from attack, defense, sp-atk, sp-def or speed", stat.value))
+		}
+		*stat.dest = parsed
+	}
+
 	switch {
-	case *mf.Natures:
-		output.WriteString(flags.NaturesFlag())
-	default:
+	case (*mf.For != "" || boost != "" || lower != "") && !*mf.Natures:
+		return fail("--for, --boost and --lower only work with --natures")
+	case boost != "" && boost == lower:
+		return fail("--boost and --lower need different stats.\nNatures that raise and lower the same stat have no effect.")
+	}
+
+	// Tables print in the order of the help menu
+	var refs []reference
+	if *mf.Natures {
+		switch {
+		case *mf.For != "":
+			ref, err := natureSuggestions(*mf.For, boost, lower)
+			if err != nil {
+				output.WriteString(err.Error())
+				return output.String(), err
+			}
+			refs = append(refs, ref)
+		case boost != "" || lower != "":
+			refs = append(refs, filteredNatures(boost, lower))
+		default:
+			refs = append(refs, natureTable())
+		}
+	}
+	for _, r := range []struct {
+		set bool
+		ref func() reference
+	}{
+		{*mf.Stages, statStages},
+		{*mf.Accuracy, accuracyStages},
+		{*mf.Crit, critStages},
+		{*mf.Weather, weatherEffects},
+		{*mf.Terrain, terrainEffects},
+		{*mf.Status, statusConditions},
+		{*mf.Exp, expCurves},
+	} {
+		if r.set {
+			refs = append(refs, r.ref())
+		}
+	}
+
+	if len(refs) == 0 {
 		usage()
+		return output.String(), nil
+	}
+
+	var err error
+	switch format {
+	case "json":
+		err = writeJSON(&output, refs)
+	case "csv":
+		if len(refs) > 1 {
+			return fail("--format csv prints one table at a time.")
+		}
+		err = refs[0].writeCSV(&output)
+	default:
+		for i, r := range refs {
+			if i > 0 {
+				output.WriteString("\n")
+			}
+			output.WriteString(r.render())
+		}
+	}
+	if err != nil {
+		return fail(err.Error())
 	}
 
 	return output.String(), nil
@@ -1,6 +1,8 @@
 package mechanics
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
@@ -69,3 +71,49 @@ func TestMechanicsCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestMechanicsCommand_Reference(t *testing.T) {
+	output, err := MechanicsCommand([]string{"mechanics", "--stages", "--terrain"})
+	require.NoError(t, err)
+	out := styling.StripANSI(output)
+	assert.Contains(t, out, "Stat Stages:")
+	assert.Contains(t, out, "Terrain:")
+	assert.Less(t, strings.Index(out, "Stat Stages:"), strings.Index(out, "Terrain:"), "tables print in help order")
+
+	output, err = MechanicsCommand([]string{"mechanics", "-n", "-S"})
+	require.NoError(t, err)
+	out = styling.StripANSI(output)
+	assert.Contains(t, out, "Nature Chart:")
+	assert.Contains(t, out, "Status Conditions:")
+
+	output, err = MechanicsCommand([]string{"mechanics", "-c", "-e", "--format", "json"})
+	require.NoError(t, err)
+	var parsed map[string][]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(output), &parsed), output)
+	assert.Len(t, parsed["crit_stages"], 5)
+	assert.Len(t, parsed["exp_curves"], 11)
+
+	output, err = MechanicsCommand([]string{"mechanics", "-a", "-f", "CSV"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(output, "stage,fraction,multiplier\n-6,3/9,"), output)
+}
+
+func TestMechanicsCommand_ReferenceErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+	}{
+		{"unknown format", []string{"mechanics", "-s", "-f", "xml"}, "--format must be table, json or csv."},
+		{"csv with two tables", []string{"mechanics", "-s", "-a", "-f", "csv"}, "one table at a time"},
+		{"extra argument", []string{"mechanics", "-s", "extra"}, "Too many arguments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := MechanicsCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.contains)
+		})
+	}
+}
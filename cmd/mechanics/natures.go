@@ -0,0 +1,242 @@
+package mechanics
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/champions"
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/shell"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+// Swapped out in tests.
+var (
+	pokemonApiCall                = connections.PokemonApiCall
+	championsConn  shell.ConnFunc = connections.CallTCGData
+)
+
+// usageThreshold is the share of Champions sets, in percent, from which a
+// move or item counts toward a suggestion.
+const usageThreshold = 10
+
+// statName is a nature stat such as "special-attack" that prints as "Sp. Atk".
+type statName string
+
+var statLabels = map[string]string{
+	"hp":              "HP",
+	"attack":          "Attack",
+	"defense":         "Defense",
+	"special-attack":  "Sp. Atk",
+	"special-defense": "Sp. Def",
+	"speed":           "Speed",
+}
+
+// statAliases are the names --boost and --lower accept for each nature stat.
+var statAliases = map[string]string{
+	"attack": "attack", "atk": "attack",
+	"defense": "defense", "def": "defense",
+	"special-attack": "special-attack", "sp-atk": "special-attack", "spatk": "special-attack", "spa": "special-attack",
+	"special-defense": "special-defense", "sp-def": "special-defense", "spdef": "special-defense", "spd": "special-defense",
+	"speed": "speed", "spe": "speed",
+}
+
+// parseStat turns "Sp. Atk", "spa" or "special_attack" into "special-attack".
+func parseStat(name string) (string, bool) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.NewReplacer(".", "", " ", "-", "_", "-").Replace(key)
+	stat, ok := statAliases[key]
+	return stat, ok
+}
+
+// natureName is the nature that raises up and lowers down.
+func natureName(up, down string) string {
+	return natureGrid[slices.Index(natureStats, up)][slices.Index(natureStats, down)]
+}
+
+// filteredNatures lists the natures that raise boost and lower lower. An
+// empty stat matches any, and neutral natures never match.
+func filteredNatures(boost, lower string) reference {
+	all := natureTable()
+	r := reference{
+		key:     all.key,
+		title:   "Natures",
+		intro:   "Each of these natures raises one stat by 10% and lowers another by 10%.",
+		headers: all.headers,
+		columns: all.columns,
+	}
+
+	var parts []string
+	if boost != "" {
+		parts = append(parts, "raise "+statLabels[boost])
+	}
+	if lower != "" {
+		parts = append(parts, "lower "+statLabels[lower])
+	}
+	r.title = "Natures that " + strings.Join(parts, " and ")
+
+	for _, row := range all.rows {
+		if row[1] == nil {
+			continue
+		}
+		if (boost == "" || row[1] == statName(boost)) && (lower == "" || row[2] == statName(lower)) {
+			r.rows = append(r.rows, row)
+		}
+	}
+	return r
+}
+
+// suggestion is a nature suggested for a Pokémon and why.
+type suggestion struct {
+	up, down string
+	reason   string
+}
+
+// recommendNatures suggests natures from a Pokémon's base stats and, when it
+// is used in Champions, its common items and Trick Room. Best first.
+func recommendNatures(stats map[string]int, usage *champions.Usage) []suggestion {
+	atk, spa, spe := stats["attack"], stats["special-attack"], stats["speed"]
+	def, spd := stats["defense"], stats["special-defense"]
+
+	percent := func(list func(champions.Usage) []champions.Common, name string) float64 {
+		if usage == nil {
+			return 0
+		}
+		return champions.Percent(list(*usage), name)
+	}
+	items := func(u champions.Usage) []champions.Common { return u.Items }
+	moves := func(u champions.Usage) []champions.Common { return u.Moves }
+	band, specs := percent(items, "Choice Band"), percent(items, "Choice Specs")
+	scarf, trickRoom := percent(items, "Choice Scarf"), percent(moves, "Trick Room")
+
+	// The attacking stat it hits with, and the stat it can afford to lower
+	main, dump := "attack", "special-attack"
+	why := fmt.Sprintf("Attack %d is higher than Sp. Atk %d", atk, spa)
+	switch {
+	case band >= usageThreshold:
+		why = fmt.Sprintf("Choice Band is on %.0f%% of its Champions sets", band)
+	case specs >= usageThreshold:
+		main, dump = "special-attack", "attack"
+		why = fmt.Sprintf("Choice Specs is on %.0f%% of its Champions sets", specs)
+	case spa > atk:
+		main, dump = "special-attack", "attack"
+		why = fmt.Sprintf("Sp. Atk %d is higher than Attack %d", spa, atk)
+	}
+	if band < usageThreshold && specs < usageThreshold && min(atk, spa) >= 90 && max(atk, spa)-min(atk, spa) <= 10 {
+		dump = "defense"
+		if spd < def {
+			dump = "special-defense"
+		}
+		why = fmt.Sprintf("Attack %d and Sp. Atk %d are both strong, so it lowers its weaker defense instead", atk, spa)
+	}
+	dumpNote := fmt.Sprintf("; it lowers %s, which it doesn't use", statLabels[dump])
+	if dump == "defense" || dump == "special-defense" {
+		dumpNote = ""
+	}
+
+	offensive := max(atk, spa) >= 100 || max(atk, spa) >= max(def, spd)
+	slow := spe <= 50 || trickRoom >= usageThreshold
+	fast := spe >= 80 || scarf >= usageThreshold
+
+	// Only name the conditions that made it count as slow
+	var slowBecause []string
+	if spe <= 50 {
+		slowBecause = append(slowBecause, fmt.Sprintf("Base Speed %d is low", spe))
+	}
+	if trickRoom >= usageThreshold {
+		slowBecause = append(slowBecause, fmt.Sprintf("Trick Room is on %.0f%% of its Champions sets", trickRoom))
+	}
+	slowReason := strings.Join(slowBecause, " and ") + ", so lowering Speed helps it move first under Trick Room"
+
+	var suggestions []suggestion
+	if offensive {
+		power := suggestion{main, dump, fmt.Sprintf("+%s for the most damage: %s%s", statLabels[main], why, dumpNote)}
+
+		speedReason := fmt.Sprintf("Base Speed %d is worth investing in: +Speed outspeeds neutral-natured Pokémon of the same base", spe)
+		if scarf >= usageThreshold {
+			speedReason += fmt.Sprintf(", and Choice Scarf is on %.0f%% of its Champions sets", scarf)
+		}
+		speed := suggestion{"speed", dump, speedReason + dumpNote}
+
+		switch {
+		case slow:
+			suggestions = append(suggestions, suggestion{main, "speed", slowReason}, power)
+		case spe >= 90 || scarf >= usageThreshold:
+			suggestions = append(suggestions, speed, power)
+		case fast:
+			suggestions = append(suggestions, power, speed)
+		default:
+			suggestions = append(suggestions, power)
+		}
+	} else {
+		// A support lowers the attacking stat it uses least
+		dump = "attack"
+		if spa < atk {
+			dump = "special-attack"
+		}
+		weaker, stronger := "defense", "special-defense"
+		if spd < def {
+			weaker, stronger = stronger, weaker
+		}
+		even := suggestion{weaker, dump, fmt.Sprintf("%s %d is its weaker defense, so raising it evens out its bulk; %s %d goes unused",
+			statLabels[weaker], stats[weaker], statLabels[dump], stats[dump])}
+		best := suggestion{stronger, dump, fmt.Sprintf("Doubles down on its best defense, %s %d", statLabels[stronger], stats[stronger])}
+
+		if slow {
+			suggestions = append(suggestions, suggestion{weaker, "speed", slowReason + ", while raising its weaker defense"})
+		}
+		suggestions = append(suggestions, even, best)
+	}
+
+	return slices.CompactFunc(suggestions, func(a, b suggestion) bool {
+		return a.up == b.up && a.down == b.down
+	})
+}
+
+// natureSuggestions looks up a Pokémon and suggests natures for it, keeping
+// only those that raise boost and lower lower when they're set.
+func natureSuggestions(pokemon, boost, lower string) (reference, error) {
+	pokemonStruct, _, err := pokemonApiCall("pokemon", strings.ToLower(pokemon), connections.APIURL)
+	if err != nil {
+		return reference{}, err
+	}
+
+	stats := map[string]int{}
+	var statLine []string
+	for _, s := range pokemonStruct.Stats {
+		stats[s.Stat.Name] = s.BaseStat
+		statLine = append(statLine, fmt.Sprintf("%s %d", statLabels[s.Stat.Name], s.BaseStat))
+	}
+
+	name := styling.CapitalizeResourceName(pokemonStruct.Name)
+	var source string
+	var usage *champions.Usage
+	switch u, ok, err := champions.PokemonUsage(championsConn, pokemonStruct.Name); {
+	case err != nil:
+		source = "Champions usage couldn't be loaded, so these come from base stats alone."
+	case !ok:
+		source = name + " isn't used in Champions, so these come from base stats alone."
+	default:
+		usage = &u
+		source = fmt.Sprintf("Champions: #%d with %.1f%% usage.", u.Rank, u.UsagePercent)
+	}
+
+	r := reference{
+		key:     "nature_suggestions",
+		title:   "Suggested Natures for " + name,
+		intro:   "Base stats: " + strings.Join(statLine, " · ") + "\n" + source,
+		headers: []string{"Nature", "Raises", "Lowers", "Why"},
+		columns: []string{"nature", "increased", "decreased", "reason"},
+		widths:  []int{0, 0, 0, 60},
+	}
+	for _, s := range recommendNatures(stats, usage) {
+		if (boost == "" || s.up == boost) && (lower == "" || s.down == lower) {
+			r.rows = append(r.rows, []any{natureName(s.up, s.down), statName(s.up), statName(s.down), s.reason})
+		}
+	}
+	if len(r.rows) == 0 {
+		r.intro += "\nNone of the suggested natures match --boost and --lower."
+	}
+	return r, nil
+}
@@ -0,0 +1,242 @@
+package mechanics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/cmd/comp/champions"
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubNatureAPI replaces the PokeAPI call with Garchomp and Hatterene and the
+// Champions data with Hatterene on Trick Room. Everything else fails to load.
+func stubNatureAPI(t *testing.T, championsErr error) {
+	t.Helper()
+	origPokemon, origConn := pokemonApiCall, championsConn
+	t.Cleanup(func() {
+		pokemonApiCall, championsConn = origPokemon, origConn
+	})
+
+	pokemonApiCall = func(endpoint, name, baseURL string) (structs.PokemonJSONStruct, string, error) {
+		base := map[string][]int{
+			"garchomp":  {108, 130, 95, 80, 85, 102},
+			"hatterene": {57, 90, 95, 136, 103, 29},
+		}
+		values, ok := base[name]
+		if !ok {
+			return structs.PokemonJSONStruct{}, name, errors.New("Pokémon not found")
+		}
+
+		var stats []string
+		for i, stat := range []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"} {
+			stats = append(stats, fmt.Sprintf(`{"base_stat": %d, "stat": {"name": %q}}`, values[i], stat))
+		}
+		var s structs.PokemonJSONStruct
+		err := json.Unmarshal([]byte(fmt.Sprintf(`{"name": %q, "stats": [%s]}`, name, strings.Join(stats, ","))), &s)
+		return s, name, err
+	}
+	championsConn = func(url string) ([]byte, error) {
+		switch {
+		case championsErr != nil:
+			return nil, championsErr
+		case strings.Contains(url, "pikalytics_usage"):
+			return []byte(`[{"rank":7,"pokemon":"Hatterene","usage_percent":22.4}]`), nil
+		}
+		return []byte(`[{"pokemon":"Hatterene","common_moves":[{"name":"Trick Room","usage_percent":88.2}],"common_items":[]}]`), nil
+	}
+}
+
+func TestParseStat(t *testing.T) {
+	for _, name := range []string{"spa", "Sp. Atk", "special_attack", " SPATK "} {
+		stat, ok := parseStat(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, "special-attack", stat, name)
+	}
+
+	_, ok := parseStat("hp")
+	assert.False(t, ok, "no nature changes HP")
+}
+
+func TestFilteredNatures(t *testing.T) {
+	natures := func(r reference) []any {
+		var names []any
+		for _, row := range r.rows {
+			names = append(names, row[0])
+		}
+		return names
+	}
+
+	r := filteredNatures("speed", "")
+	assert.Equal(t, "Natures that raise Speed", r.title)
+	assert.Equal(t, []any{"Timid", "Hasty", "Jolly", "Naive"}, natures(r))
+
+	r = filteredNatures("", "attack")
+	assert.Equal(t, []any{"Bold", "Modest", "Calm", "Timid"}, natures(r))
+
+	r = filteredNatures("attack", "speed")
+	assert.Equal(t, "Natures that raise Attack and lower Speed", r.title)
+	assert.Equal(t, []any{"Brave"}, natures(r))
+}
+
+func TestRecommendNatures(t *testing.T) {
+	natures := func(suggestions []suggestion) []string {
+		var names []string
+		for _, s := range suggestions {
+			names = append(names, natureName(s.up, s.down))
+		}
+		return names
+	}
+
+	tests := []struct {
+		name  string
+		stats map[string]int
+		usage *champions.Usage
+		want  []string
+	}{
+		{
+			name:  "fast physical attacker",
+			stats: map[string]int{"attack": 130, "defense": 95, "special-attack": 80, "special-defense": 85, "speed": 102},
+			want:  []string{"Jolly", "Adamant"},
+		},
+		{
+			name:  "slow physical attacker",
+			stats: map[string]int{"attack": 134, "defense": 110, "special-attack": 70, "special-defense": 70, "speed": 30},
+			want:  []string{"Brave", "Adamant"},
+		},
+		{
+			name:  "special attacker on Choice Specs",
+			stats: map[string]int{"attack": 105, "defense": 75, "special-attack": 100, "special-defense": 85, "speed": 70},
+			usage: &champions.Usage{Items: []champions.Common{{Name: "Choice Specs", UsagePercent: 45}}},
+			want:  []string{"Modest"},
+		},
+		{
+			name:  "Choice Scarf puts Speed first",
+			stats: map[string]int{"attack": 120, "defense": 80, "special-attack": 60, "special-defense": 80, "speed": 85},
+			usage: &champions.Usage{Items: []champions.Common{{Name: "Choice Scarf", UsagePercent: 30}}},
+			want:  []string{"Jolly", "Adamant"},
+		},
+		{
+			name:  "mixed attacker lowers a defense",
+			stats: map[string]int{"attack": 110, "defense": 70, "special-attack": 110, "special-defense": 60, "speed": 70},
+			want:  []string{"Naughty"},
+		},
+		{
+			name:  "defensive support",
+			stats: map[string]int{"attack": 45, "defense": 130, "special-attack": 60, "special-defense": 90, "speed": 30},
+			want:  []string{"Sassy", "Calm", "Bold"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suggestions := recommendNatures(tt.stats, tt.usage)
+			assert.Equal(t, tt.want, natures(suggestions))
+			for _, s := range suggestions {
+				assert.NotEmpty(t, s.reason)
+			}
+		})
+	}
+}
+
+func TestRecommendNatures_SlowReason(t *testing.T) {
+	fastAttacker := map[string]int{"attack": 125, "defense": 80, "special-attack": 60, "special-defense": 80, "speed": 95}
+	trickRoom := &champions.Usage{Moves: []champions.Common{{Name: "Trick Room", UsagePercent: 40}}}
+
+	suggestions := recommendNatures(fastAttacker, trickRoom)
+	require.NotEmpty(t, suggestions)
+	assert.Equal(t, "Brave", natureName(suggestions[0].up, suggestions[0].down))
+	assert.Equal(t, "Trick Room is on 40% of its Champions sets, so lowering Speed helps it move first under Trick Room", suggestions[0].reason)
+
+	slowAttacker := map[string]int{"attack": 134, "defense": 110, "special-attack": 70, "special-defense": 70, "speed": 30}
+	suggestions = recommendNatures(slowAttacker, nil)
+	require.NotEmpty(t, suggestions)
+	assert.Equal(t, "Base Speed 30 is low, so lowering Speed helps it move first under Trick Room", suggestions[0].reason)
+
+	suggestions = recommendNatures(slowAttacker, trickRoom)
+	require.NotEmpty(t, suggestions)
+	assert.Equal(t, "Base Speed 30 is low and Trick Room is on 40% of its Champions sets, so lowering Speed helps it move first under Trick Room", suggestions[0].reason)
+}
+
+func TestNatureSuggestions(t *testing.T) {
+	stubNatureAPI(t, nil)
+
+	r, err := natureSuggestions("Hatterene", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Suggested Natures for Hatterene", r.title)
+	assert.Contains(t, r.intro, "Sp. Atk 136")
+	assert.Contains(t, r.intro, "Champions: #7 with 22.4% usage.")
+	require.NotEmpty(t, r.rows)
+	assert.Equal(t, "Quiet", r.rows[0][0])
+	assert.Contains(t, r.rows[0][3], "Trick Room is on 88% of its Champions sets")
+
+	r, err = natureSuggestions("garchomp", "speed", "")
+	require.NoError(t, err)
+	assert.Contains(t, r.intro, "isn't used in Champions")
+	require.Len(t, r.rows, 1)
+	assert.Equal(t, "Jolly", r.rows[0][0])
+
+	r, err = natureSuggestions("garchomp", "defense", "")
+	require.NoError(t, err)
+	assert.Empty(t, r.rows)
+	assert.Contains(t, r.intro, "None of the suggested natures match")
+
+	_, err = natureSuggestions("missingno", "", "")
+	assert.EqualError(t, err, "Pokémon not found")
+}
+
+func TestNatureSuggestions_ChampionsError(t *testing.T) {
+	stubNatureAPI(t, errors.New("offline"))
+
+	r, err := natureSuggestions("hatterene", "", "")
+	require.NoError(t, err)
+	assert.Contains(t, r.intro, "couldn't be loaded")
+	assert.Equal(t, "Quiet", r.rows[0][0], "Base Speed 29 is slow without usage data")
+	assert.NotContains(t, r.rows[0][3], "Champions sets")
+}
+
+func TestMechanicsCommand_NatureFlags(t *testing.T) {
+	stubNatureAPI(t, nil)
+
+	output, err := MechanicsCommand([]string{"mechanics", "-n", "--for", "garchomp"})
+	require.NoError(t, err)
+	out := styling.StripANSI(output)
+	assert.Contains(t, out, "Suggested Natures for Garchomp")
+	assert.Contains(t, out, "Jolly")
+
+	output, err = MechanicsCommand([]string{"mechanics", "-n", "-b", "spe", "-l", "atk"})
+	require.NoError(t, err)
+	out = styling.StripANSI(output)
+	assert.Contains(t, out, "Natures that raise Speed and lower Attack")
+	assert.Contains(t, out, "Timid")
+	assert.NotContains(t, out, "Jolly")
+
+	output, err = MechanicsCommand([]string{"mechanics", "-n", "-b", "speed", "-f", "json"})
+	require.NoError(t, err)
+	var decoded map[string][]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(output), &decoded))
+	assert.Len(t, decoded["natures"], 4)
+
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+	}{
+		{"for without natures", []string{"mechanics", "-s", "--for", "garchomp"}, "only work with --natures"},
+		{"boost without natures", []string{"mechanics", "-b", "speed"}, "only work with --natures"},
+		{"unknown stat", []string{"mechanics", "-n", "-b", "luck"}, `Unknown stat "luck"`},
+		{"same stat", []string{"mechanics", "-n", "-b", "atk", "-l", "attack"}, "need different stats"},
+		{"unknown Pokémon", []string{"mechanics", "-n", "--for", "missingno"}, "Pokémon not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := MechanicsCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.contains)
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package mechanics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/digitalghost-dev/poke-cli/cmd/speed"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+// Cell types that print differently in a table but export as plain numbers.
+type (
+	// stage prints with its sign, e.g. "+2".
+	stage int
+	// multiplier prints as "1.5x".
+	multiplier float64
+	// chance is a probability from 0 to 1 that prints as a percentage.
+	chance float64
+)
+
+// reference is one table of the mechanics reference. Columns are the keys
+// used by JSON and CSV; headers are what the table shows.
+type reference struct {
+	key     string
+	title   string
+	intro   string
+	headers []string
+	columns []string
+	// widths caps the width of text-heavy columns so they wrap; 0 leaves a column as wide as it needs.
+	widths []int
+	rows   [][]any
+	// text, when set, is printed instead of the table in the table format.
+	text func() string
+}
+
+// statStages lists the multipliers of the stat stages shared with the speed calculator.
+func statStages() reference {
+	r := reference{
+		key:     "stat_stages",
+		title:   "Stat Stages",
+		intro:   "Moves like Swords Dance and Intimidate raise or lower Attack, Defense, Sp. Atk, Sp. Def and Speed\nby stages, from -6 to +6. Stages reset when the Pokémon switches out.",
+		headers: []string{"Stage", "Fraction", "Multiplier"},
+		columns: []string{"stage", "fraction", "multiplier"},
+	}
+	for s := -6; s <= 6; s++ {
+		r.rows = append(r.rows, []any{stage(s), stageFraction(s, 2), multiplier(speed.StageMultiplier(s))})
+	}
+	return r
+}
+
+// accuracyStages lists the accuracy and evasion stage multipliers.
+func accuracyStages() reference {
+	r := reference{
+		key:     "accuracy_stages",
+		title:   "Accuracy and Evasion Stages",
+		intro:   "The stage is the user's accuracy stage minus the target's evasion stage, capped at -6 and +6.\nIt multiplies the move's accuracy.",
+		headers: []string{"Stage", "Fraction", "Multiplier"},
+		columns: []string{"stage", "fraction", "multiplier"},
+	}
+	for s := -6; s <= 6; s++ {
+		num, den := 3+max(s, 0), 3-min(s, 0)
+		r.rows = append(r.rows, []any{stage(s), stageFraction(s, 3), multiplier(float64(num) / float64(den))})
+	}
+	return r
+}
+
+// stageFraction writes a stage as a fraction over base, e.g. stage -2 over 2 is "2/4".
+func stageFraction(s, base int) string {
+	return fmt.Sprintf("%d/%d", base+max(s, 0), base-min(s, 0))
+}
+
+// critStages lists the critical hit chance of each stage by generation.
+func critStages() reference {
+	return reference{
+		key:   "crit_stages",
+		title: "Critical Hit Stages",
+		intro: "High critical hit ratio moves, Scope Lens, Razor Claw and Super Luck add one stage; Focus Energy and\n" +
+			"Dire Hit add two. Critical hits deal 1.5x damage (2x before Gen 6) and ignore the target's raised\n" +
+			"defenses and the user's lowered attack.",
+		headers: []string{"Stage", "Gen 2-5", "Gen 6", "Gen 7+"},
+		columns: []string{"stage", "gen_2_5", "gen_6", "gen_7_plus"},
+		rows: [][]any{
+			{stage(0), chance(1.0 / 16), chance(1.0 / 16), chance(1.0 / 24)},
+			{stage(1), chance(1.0 / 8), chance(1.0 / 8), chance(1.0 / 8)},
+			{stage(2), chance(1.0 / 4), chance(1.0 / 2), chance(1.0 / 2)},
+			{stage(3), chance(1.0 / 3), chance(1), chance(1)},
+			{stage(4), chance(1.0 / 2), chance(1), chance(1)},
+		},
+	}
+}
+
+// weatherEffects lists the weather conditions as of Gen 9.
+func weatherEffects() reference {
+	return reference{
+		key:     "weather",
+		title:   "Weather",
+		intro:   "Weather set by a move or ability lasts 5 turns, or 8 with the matching rock.\nThe primal weathers last while their user is in battle and can't be replaced by other weather.",
+		headers: []string{"Weather", "Set By", "Item", "Effects"},
+		columns: []string{"weather", "set_by", "item", "effects"},
+		widths:  []int{0, 0, 0, 58},
+		rows: [][]any{
+			{"Harsh sunlight", "Sunny Day, Drought", "Heat Rock", "Fire moves 1.5x, Water moves 0.5x. Nothing can be frozen. Solar Beam needs no charging turn. Thunder and Hurricane are 50% accurate. Chlorophyll doubles Speed."},
+			{"Rain", "Rain Dance, Drizzle", "Damp Rock", "Water moves 1.5x, Fire moves 0.5x. Thunder and Hurricane never miss. Solar Beam deals half damage. Swift Swim doubles Speed."},
+			{"Sandstorm", "Sandstorm, Sand Stream", "Smooth Rock", "Deals 1/16 max HP each turn, except to Rock, Ground and Steel types. Rock types get 1.5x Sp. Def. Sand Rush doubles Speed."},
+			{"Snow", "Snowscape, Snow Warning", "Icy Rock", "Ice types get 1.5x Defense. Blizzard never misses. Slush Rush doubles Speed. Before Gen 9, Hail dealt 1/16 max HP each turn to all but Ice types instead."},
+			{"Extremely harsh sunlight", "Desolate Land", nil, "As harsh sunlight, and Water moves fail."},
+			{"Heavy rain", "Primordial Sea", nil, "As rain, and Fire moves fail."},
+			{"Strong winds", "Delta Stream", nil, "Moves that are super effective against Flying types deal neutral damage to them."},
+		},
+	}
+}
+
+// terrainEffects lists the terrains as of Gen 8.
+func terrainEffects() reference {
+	return reference{
+		key:   "terrain",
+		title: "Terrain",
+		intro: "Terrain lasts 5 turns, or 8 with a Terrain Extender. It only affects grounded Pokémon,\n" +
+			"so not Flying types or Pokémon with Levitate or an Air Balloon.",
+		headers: []string{"Terrain", "Set By", "Effects"},
+		columns: []string{"terrain", "set_by", "effects"},
+		widths:  []int{0, 0, 58},
+		rows: [][]any{
+			{"Electric", "Electric Terrain, Electric Surge", "Electric moves 1.3x (1.5x in Gen 7). Grounded Pokémon can't fall asleep."},
+			{"Grassy", "Grassy Terrain, Grassy Surge", "Grass moves 1.3x (1.5x in Gen 7). Grounded Pokémon heal 1/16 max HP each turn. Earthquake, Bulldoze and Magnitude deal half damage."},
+			{"Misty", "Misty Terrain, Misty Surge", "Dragon moves deal half damage to grounded Pokémon, which also can't get a status condition or be confused."},
+			{"Psychic", "Psychic Terrain, Psychic Surge", "Psychic moves 1.3x (1.5x in Gen 7). Grounded Pokémon are protected from priority moves."},
+		},
+	}
+}
+
+// statusConditions lists the status conditions as of Gen 9.
+func statusConditions() reference {
+	return reference{
+		key:     "status_conditions",
+		title:   "Status Conditions",
+		intro:   "A Pokémon can only have one of burn, freeze, paralysis, poison or sleep, and keeps it after switching out.\nConfusion stacks with them and ends on switching out.",
+		headers: []string{"Condition", "Damage", "Effects", "Immune"},
+		columns: []string{"condition", "damage", "effects", "immune"},
+		widths:  []int{0, 22, 44, 0},
+		rows: [][]any{
+			{"Burn", "1/16 max HP per turn (1/8 before Gen 7)", "Physical moves deal half damage, unless the user has Guts or uses Facade.", "Fire types"},
+			{"Freeze", nil, "Can't move. Thaws with a 20% chance each turn, when hit by a Fire move, or when using a move like Scald.", "Ice types"},
+			{"Paralysis", nil, "Speed halved (quartered before Gen 7). 25% chance each turn to be fully paralyzed.", "Electric types"},
+			{"Poison", "1/8 max HP per turn", nil, "Poison and Steel types"},
+			{"Bad poison", "n/16 max HP on the nth turn", "The damage counter resets when the Pokémon switches out.", "Poison and Steel types"},
+			{"Sleep", nil, "Can't move for 1 to 3 turns. Sleep Talk and Snore still work.", nil},
+			{"Confusion", nil, "Lasts 2 to 5 turns. 33% chance each turn (50% before Gen 7) to hit itself with a 40 power physical attack.", nil},
+		},
+	}
+}
+
+// growthRates are the six EXP curves, in the order of the EXP table.
+var growthRates = []struct {
+	key, header string
+}{
+	{"erratic", "Erratic"},
+	{"fast", "Fast"},
+	{"medium_fast", "Medium Fast"},
+	{"medium_slow", "Medium Slow"},
+	{"slow", "Slow"},
+	{"fluctuating", "Fluctuating"},
+}
+
+// expForLevel is the total EXP a Pokémon with a growth rate needs to reach level n.
+func expForLevel(rate string, n int) int {
+	if n <= 1 {
+		return 0
+	}
+	cube := n * n * n
+	switch rate {
+	case "erratic":
+		switch {
+		case n < 50:
+			return cube * (100 - n) / 50
+		case n < 68:
+			return cube * (150 - n) / 100
+		case n < 98:
+			return cube * ((1911 - 10*n) / 3) / 500
+		default:
+			return cube * (160 - n) / 100
+		}
+	case "fast":
+		return 4 * cube / 5
+	case "medium_fast":
+		return cube
+	case "medium_slow":
+		return 6*cube/5 - 15*n*n + 100*n - 140
+	case "slow":
+		return 5 * cube / 4
+	case "fluctuating":
+		switch {
+		case n < 15:
+			return cube * ((n+1)/3 + 24) / 50
+		case n < 36:
+			return cube * (n + 14) / 50
+		default:
+			return cube * (n/2 + 32) / 50
+		}
+	}
+	return 0
+}
+
+// expCurves lists the total EXP each growth rate needs at a few levels.
+func expCurves() reference {
+	r := reference{
+		key:     "exp_curves",
+		title:   "EXP Curves",
+		intro:   "Each species levels up along one of six growth rates.\nThe table shows the total EXP needed to reach a level.",
+		headers: []string{"Level"},
+		columns: []string{"level"},
+	}
+	for _, g := range growthRates {
+		r.headers = append(r.headers, g.header)
+		r.columns = append(r.columns, g.key)
+	}
+	for _, level := range []int{5, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100} {
+		row := []any{level}
+		for _, g := range growthRates {
+			row = append(row, expForLevel(g.key, level))
+		}
+		r.rows = append(r.rows, row)
+	}
+	return r
+}
+
+// natureStats are the stats natures raise and lower, in the order of the nature chart.
+var natureStats = []string{"attack", "defense", "special-attack", "special-defense", "speed"}
+
+// natureGrid is the nature chart: rows raise a stat and columns lower one.
+var natureGrid = [][]string{
+	{"Hardy", "Lonely", "Adamant", "Naughty", "Brave"},
+	{"Bold", "Docile", "Impish", "Lax", "Relaxed"},
+	{"Modest", "Mild", "Bashful", "Rash", "Quiet"},
+	{"Calm", "Gentle", "Careful", "Quirky", "Sassy"},
+	{"Timid", "Hasty", "Jolly", "Naive", "Serious"},
+}
+
+// natureTable lists the natures for JSON and CSV. The table format prints
+// the nature chart from flags.NaturesFlag instead.
+func natureTable() reference {
+	r := reference{
+		key:     "natures",
+		title:   "Natures",
+		headers: []string{"Nature", "Increased", "Decreased"},
+		columns: []string{"nature", "increased", "decreased"},
+		text:    flags.NaturesFlag,
+	}
+	for up, row := range natureGrid {
+		for down, name := range row {
+			if up == down {
+				r.rows = append(r.rows, []any{name, nil, nil})
+				continue
+			}
+			r.rows = append(r.rows, []any{name, statName(natureStats[up]), statName(natureStats[down])})
+		}
+	}
+	return r
+}
+
+// cellText is how a cell prints in the table format.
+func cellText(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "—"
+	case stage:
+		if v == 0 {
+			return "0"
+		}
+		return fmt.Sprintf("%+d", int(v))
+	case multiplier:
+		return strconv.FormatFloat(math.Round(float64(v)*100)/100, 'f', -1, 64) + "x"
+	case chance:
+		return strconv.FormatFloat(math.Round(float64(v)*10000)/100, 'f', -1, 64) + "%"
+	case statName:
+		return statLabels[string(v)]
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// csvCell is how a cell prints in CSV: numbers stay unformatted and nil is empty.
+func csvCell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case stage:
+		return strconv.Itoa(int(v))
+	case multiplier:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case chance:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// render prints the reference as a titled table.
+func (r reference) render() string {
+	if r.text != nil {
+		return r.text()
+	}
+
+	var output strings.Builder
+
+	output.WriteString(r.intro)
+	output.WriteString("\n\n")
+	output.WriteString(styling.StyleBold.Render(r.title + ":"))
+	output.WriteString("\n")
+
+	rows := make([][]string, len(r.rows))
+	for i, row := range r.rows {
+		rows[i] = make([]string, len(row))
+		for j, cell := range row {
+			rows[i][j] = cellText(cell)
+		}
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(styling.Gray)).
+		BorderRow(true).
+		BorderColumn(true).
+		Headers(r.headers...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == table.HeaderRow {
+				style = style.Bold(true)
+			}
+			if col < len(r.widths) && r.widths[col] > 0 {
+				style = style.Width(r.widths[col])
+			}
+			return style
+		})
+
+	output.WriteString(t.Render())
+	output.WriteString("\n")
+
+	return output.String()
+}
+
+// writeCSV writes one reference with a header row of its column keys.
+func (r reference) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(r.columns); err != nil {
+		return err
+	}
+	record := make([]string, len(r.columns))
+	for _, row := range r.rows {
+		for i, cell := range row {
+			record[i] = csvCell(cell)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJSON writes the references as one object keyed by reference. Each
+// reference is an array of records whose keys keep the column order.
+func writeJSON(w io.Writer, refs []reference) error {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i, r := range refs {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		fmt.Fprintf(&buf, "\n  %q: [", r.key)
+		for j, row := range r.rows {
+			if j > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n    {")
+			for k, cell := range row {
+				if k > 0 {
+					buf.WriteString(", ")
+				}
+				v, err := json.Marshal(cell)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(&buf, "%q: %s", r.columns[k], v)
+			}
+			buf.WriteString("}")
+		}
+		buf.WriteString("\n  ]")
+	}
+	buf.WriteString("\n}\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
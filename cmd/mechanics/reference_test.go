@@ -0,0 +1,105 @@
+package mechanics
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatStages(t *testing.T) {
+	r := statStages()
+	require.Len(t, r.rows, 13)
+	assert.Equal(t, []any{stage(-6), "2/8", multiplier(0.25)}, r.rows[0])
+	assert.Equal(t, []any{stage(0), "2/2", multiplier(1)}, r.rows[6])
+	assert.Equal(t, []any{stage(2), "4/2", multiplier(2)}, r.rows[8])
+}
+
+func TestAccuracyStages(t *testing.T) {
+	r := accuracyStages()
+	require.Len(t, r.rows, 13)
+	assert.Equal(t, []any{stage(-6), "3/9", multiplier(1.0 / 3)}, r.rows[0])
+	assert.Equal(t, []any{stage(1), "4/3", multiplier(4.0 / 3)}, r.rows[7])
+	assert.Equal(t, []any{stage(6), "9/3", multiplier(3)}, r.rows[12])
+}
+
+func TestExpForLevel(t *testing.T) {
+	// Level 100 totals of each growth rate
+	for rate, want := range map[string]int{
+		"erratic": 600000, "fast": 800000, "medium_fast": 1000000,
+		"medium_slow": 1059860, "slow": 1250000, "fluctuating": 1640000,
+	} {
+		assert.Equal(t, want, expForLevel(rate, 100), rate)
+	}
+
+	// Each branch of the piecewise curves
+	assert.Equal(t, 237, expForLevel("erratic", 5))
+	assert.Equal(t, 125000, expForLevel("erratic", 50))
+	assert.Equal(t, 276458, expForLevel("erratic", 70))
+	assert.Equal(t, 583539, expForLevel("erratic", 98))
+	assert.Equal(t, 540, expForLevel("fluctuating", 10))
+	assert.Equal(t, 5440, expForLevel("fluctuating", 20))
+	assert.Equal(t, 142500, expForLevel("fluctuating", 50))
+	assert.Equal(t, 135, expForLevel("medium_slow", 5))
+
+	assert.Equal(t, 0, expForLevel("medium_slow", 1))
+	assert.Equal(t, 0, expForLevel("unknown", 50))
+}
+
+func TestNatureTable(t *testing.T) {
+	r := natureTable()
+	require.Len(t, r.rows, 25)
+	assert.Equal(t, []any{"Adamant", statName("attack"), statName("special-attack")}, r.rows[2])
+	assert.Equal(t, []any{"Timid", statName("speed"), statName("attack")}, r.rows[20])
+	assert.Equal(t, []any{"Serious", nil, nil}, r.rows[24])
+}
+
+func TestCellText(t *testing.T) {
+	assert.Equal(t, "+2", cellText(stage(2)))
+	assert.Equal(t, "-1", cellText(stage(-1)))
+	assert.Equal(t, "0", cellText(stage(0)))
+	assert.Equal(t, "0.29x", cellText(multiplier(2.0/7)))
+	assert.Equal(t, "4.17%", cellText(chance(1.0/24)))
+	assert.Equal(t, "100%", cellText(chance(1)))
+	assert.Equal(t, "—", cellText(nil))
+	assert.Equal(t, "Sp. Atk", cellText(statName("special-attack")))
+	assert.Equal(t, "Rain", cellText("Rain"))
+}
+
+func TestReference_Render(t *testing.T) {
+	out := styling.StripANSI(weatherEffects().render())
+	assert.Contains(t, out, "Weather:")
+	assert.Contains(t, out, "Harsh sunlight")
+	assert.Contains(t, out, "Delta Stream")
+
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n")[4:] {
+		assert.LessOrEqual(t, len([]rune(line)), 130, "effects should wrap: %q", line)
+	}
+}
+
+func TestReference_WriteCSV(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, critStages().writeCSV(&sb))
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	require.Len(t, lines, 6)
+	assert.Equal(t, "stage,gen_2_5,gen_6,gen_7_plus", lines[0])
+	assert.Equal(t, "0,0.0625,0.0625,0.041666666666666664", lines[1])
+	assert.Equal(t, "4,0.5,1,1", lines[5])
+}
+
+func TestWriteJSON(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, writeJSON(&sb, []reference{statStages(), weatherEffects()}))
+
+	var parsed map[string][]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(sb.String()), &parsed), sb.String())
+	require.Len(t, parsed["stat_stages"], 13)
+	assert.Equal(t, map[string]any{"stage": -6.0, "fraction": "2/8", "multiplier": 0.25}, parsed["stat_stages"][0])
+	assert.Nil(t, parsed["weather"][4]["item"], "primal weathers have no item")
+
+	// Keys keep the column order
+	assert.True(t, strings.Index(sb.String(), `"stage"`) < strings.Index(sb.String(), `"fraction"`))
+}
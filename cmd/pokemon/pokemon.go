@@ -28,9 +28,12 @@ func PokemonCommand(args []string) (string, error) {
 					ShowHyphenHint: true,
 					Flags: []utils.FlagHelp{
 						{Short: "-a", Long: "--abilities", Description: "Prints the Pokémon's abilities."},
+						{Short: "", Long: "--cry[=xx]", Description: "Plays the Pokémon's cry, or saves it with --save.\n\t     " + styling.StyleItalic.Render("options: [latest, legacy]")},
 						{Short: "-d", Long: "--defenses", Description: "Prints the Pokémon's type defenses."},
 						{Short: "-i=xx", Long: "--image=xx", Description: "Prints out the Pokémon's default sprite.\n\t     " + styling.StyleItalic.Render("options: [sm, md, lg]")},
 						{Short: "-m", Long: "--moves", Description: "Prints the Pokémon's learnable moves."},
+						{Short: "", Long: "--save=dir", Description: "With --image or --cry, saves the file to a directory."},
+						{Short: "", Long: "--sprite=xx", Description: "With --image, picks the sprite variant.\n\t     " + styling.StyleItalic.Render("options: [shiny, female, back, artwork, home, animated]")},
 						{Short: "-s", Long: "--stats", Description: "Prints the Pokémon's base stats."},
 					},
 				},
@@ -61,6 +64,24 @@ func PokemonCommand(args []string) (string, error) {
 		return output.String(), err
 	}
 
+	if *pf.Save != "" && *pf.Image == "" && *pf.Cry == "" {
+		err := fmt.Errorf("%s", utils.FormatError("The --save flag requires --image or --cry.\nExample: poke-cli pokemon pikachu --image=md --save=./sprites"))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	if *pf.Sprite != "" && *pf.Image == "" {
+		err := fmt.Errorf("%s", utils.FormatError("The --sprite flag requires --image.\nExample: poke-cli pokemon pikachu --image=md --sprite=shiny"))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	variant, err := flags.ParseSpriteVariant(*pf.Sprite)
+	if err != nil {
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
 	pokemonStruct, pokemonName, err := connections.PokemonApiCall(endpoint, pokemonName, connections.APIURL)
 	if err != nil {
 		output.WriteString(err.Error())
@@ -100,8 +121,22 @@ func PokemonCommand(args []string) (string, error) {
 		// Determine the size based on the provided flags
 		size := *pf.Image
 
-		// Call the ImageFlag function with the specified size
-		if err := flags.ImageFlag(&output, endpoint, pokemonName, size); err != nil {
+		// Call the SpriteFlag function with the specified size and variant
+		if err := flags.SpriteFlag(&output, endpoint, pokemonName, size, variant); err != nil {
+			fmt.Fprintf(&output, "%v\n", err)
+			return output.String(), fmt.Errorf("%w", err)
+		}
+
+		if *pf.Save != "" {
+			if err := flags.SaveImageFlag(&output, endpoint, pokemonName, *pf.Save, variant); err != nil {
+				fmt.Fprintf(&output, "%v\n", err)
+				return output.String(), fmt.Errorf("%w", err)
+			}
+		}
+	}
+
+	if *pf.Cry != "" {
+		if err := flags.CryFlag(&output, endpoint, pokemonName, *pf.Cry, *pf.Save); err != nil {
 			fmt.Fprintf(&output, "%v\n", err)
 			return output.String(), fmt.Errorf("%w", err)
 		}
@@ -77,6 +77,24 @@ func TestPokemonCommand(t *testing.T) {
 			expectedOutput: utils.LoadGolden(t, "pokemon_image_flag_empty_flag.golden"),
 			expectedError:  true,
 		},
+		{
+			name:           "Pokemon save flag without image flag",
+			args:           []string{"pokemon", "pikachu", "--save=./sprites"},
+			expectedOutput: utils.LoadGolden(t, "pokemon_save_without_image.golden"),
+			expectedError:  true,
+		},
+		{
+			name:           "Pokemon sprite flag without image flag",
+			args:           []string{"pokemon", "pikachu", "--sprite=shiny"},
+			expectedOutput: utils.LoadGolden(t, "pokemon_sprite_without_image.golden"),
+			expectedError:  true,
+		},
+		{
+			name:           "Pokemon sprite flag invalid option",
+			args:           []string{"pokemon", "pikachu", "--image=sm", "--sprite=gold"},
+			expectedOutput: utils.LoadGolden(t, "pokemon_sprite_invalid_option.golden"),
+			expectedError:  true,
+		},
 		{
 			name:           "Pokemon stats flag",
 			args:           []string{"pokemon", "toxicroak", "--stats"},
@@ -246,13 +246,37 @@ func form() *huh.Form {
 	return form
 }
 
-func formula() (string, error) {
-	modifierMultiplier := 1.0 // start with no change
-	for _, mod := range pokemon.Modifier {
+// Calculate returns a speed stat using the formula:
+// (((2 x base + IV + (EV / 4)) x level / 100 + 5) x each multiplier, rounded down.
+// Multipliers cover nature, ability, speed stage and modifiers like Choice Scarf.
+func Calculate(base, iv, ev, level int, multipliers ...float64) int {
+	speed := float64(((2*base + iv + (ev / 4)) * level / 100) + 5)
+	for _, m := range multipliers {
+		speed *= m
+	}
+	return int(math.Floor(speed))
+}
+
+// ModifierMultiplier combines the multipliers of the named modifiers, such as
+// "Choice Scarf" and "Tailwind". Unknown names are ignored.
+func ModifierMultiplier(modifiers []string) float64 {
+	multiplier := 1.0 // start with no change
+	for _, mod := range modifiers {
 		if val, ok := modifierMultipliers[mod]; ok {
-			modifierMultiplier *= val
+			multiplier *= val
 		}
 	}
+	return multiplier
+}
+
+// StageMultiplier is the stat multiplier of a stat stage, from -6 (x0.25) to
+// +6 (x4). Stages outside that range are clamped, as they are in battle.
+func StageMultiplier(stage int) float64 {
+	return stageMultipliers[max(-6, min(6, stage))]
+}
+
+func formula() (string, error) {
+	modifierMultiplier := ModifierMultiplier(pokemon.Modifier)
 
 	abilityMultiplier := abilityMultipliers[pokemon.Ability]
 
@@ -260,7 +284,7 @@ func formula() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("invalid SpeedStage: %w", err)
 	}
-	stageMultiplier := stageMultipliers[speedStageInt]
+	stageMultiplier := StageMultiplier(speedStageInt)
 
 	// Get the Pokémon's base speed using the DefaultSpeedStat function
 	speedStr, err := DefaultSpeedStat(pokemon.Name)
@@ -291,13 +315,7 @@ func formula() (string, error) {
 
 	chosenPokemon := cases.Title(language.English).String(pokemon.Name)
 
-	// Calculate final speed using the formula:
-	// (((2 x base + IV + (EV / 4)) x level / 100 + 5) * nature * modifier * ability * stage
-	finalSpeed := float64(((2*baseSpeed+intIV+(intEV/4))*intLevel/100)+5) * natureMultiplier * abilityMultiplier * stageMultiplier * modifierMultiplier
-
-	// Round down the final speed
-	finalSpeedFloor := math.Floor(finalSpeed)
-	finalSpeedStr := fmt.Sprintf("%.0f", finalSpeedFloor)
+	finalSpeedStr := strconv.Itoa(Calculate(baseSpeed, intIV, intEV, intLevel, natureMultiplier, abilityMultiplier, stageMultiplier, modifierMultiplier))
 
 	header := fmt.Sprintf("%s at level %s with selected options has a current speed of %s.",
 		styling.Theme.Render(chosenPokemon),
@@ -246,3 +246,42 @@ func TestFormula(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name        string
+		base        int
+		iv, ev      int
+		level       int
+		multipliers []float64
+		expected    int
+	}{
+		{"uninvested", 150, 31, 0, 50, nil, 170},
+		{"max speed", 150, 31, 252, 50, []float64{1.1}, 222},
+		{"minimum speed", 150, 31, 0, 50, []float64{0.9}, 153},
+		{"max speed with scarf", 150, 31, 252, 50, []float64{1.1, 1.5}, 333},
+		{"zero IVs at level 100", 30, 0, 0, 100, []float64{0.9}, 58},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Calculate(tt.base, tt.iv, tt.ev, tt.level, tt.multipliers...))
+		})
+	}
+}
+
+func TestModifierMultiplier(t *testing.T) {
+	assert.Equal(t, 1.0, ModifierMultiplier(nil))
+	assert.Equal(t, 1.5, ModifierMultiplier([]string{"Choice Scarf"}))
+	assert.Equal(t, 3.0, ModifierMultiplier([]string{"Choice Scarf", "Tailwind"}))
+	assert.Equal(t, 2.0, ModifierMultiplier([]string{"Tailwind", "Unknown"}))
+}
+
+func TestStageMultiplier(t *testing.T) {
+	assert.Equal(t, 1.0, StageMultiplier(0))
+	assert.Equal(t, 1.5, StageMultiplier(1))
+	assert.Equal(t, 0.25, StageMultiplier(-6))
+	assert.Equal(t, 4.0, StageMultiplier(6))
+	assert.Equal(t, 4.0, StageMultiplier(8), "stages above +6 are clamped")
+	assert.Equal(t, 0.25, StageMultiplier(-7), "stages below -6 are clamped")
+}
@@ -0,0 +1,317 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/flags"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/digitalghost-dev/poke-cli/typechart"
+	flag "github.com/spf13/pflag"
+)
+
+// multiplierTiers are the rows of a defense breakdown, most damage first.
+var multiplierTiers = []float64{4, 2, 1, 0.5, 0.25, 0.125, 0}
+
+// formatMultiplier writes 0.5 as "½x" and 4 as "4x".
+func formatMultiplier(m float64) string {
+	switch m {
+	case 0.5:
+		return "½x"
+	case 0.25:
+		return "¼x"
+	case 0.125:
+		return "⅛x"
+	}
+	return fmt.Sprintf("%gx", m)
+}
+
+func coloredType(name string) string {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(styling.GetTypeColor(name))).
+		Render(styling.CapitalizeResourceName(name))
+}
+
+func coloredTypes(names []string, sep string) string {
+	colored := make([]string, len(names))
+	for i, n := range names {
+		colored[i] = coloredType(n)
+	}
+	return strings.Join(colored, sep)
+}
+
+// defenseMultipliers is the damage each attacking type deals to the
+// defending types, after the Tera type and the ability.
+func defenseMultipliers(chart *typechart.Chart, defending []string, tera, ability string) map[string]float64 {
+	attackers := chart.Types()
+	if tera != "" {
+		attackers = append(attackers, typechart.Stellar)
+	}
+
+	multipliers := make(map[string]float64, len(attackers))
+	for _, a := range attackers {
+		multipliers[a] = chart.TeraEffectiveness(a, tera, defending...) * typechart.AbilityModifier(ability, a)
+	}
+	return multipliers
+}
+
+// DefenseBreakdown renders the damage one or two defending types take from
+// every attacking type, grouped into 4x/2x/1x/½x/¼x/0x.
+func DefenseBreakdown(chart *typechart.Chart, defending []string, tera, ability string) string {
+	var out strings.Builder
+
+	heading := coloredTypes(defending, "/")
+	if tera != "" {
+		heading += " · Tera " + coloredType(tera)
+	}
+	if ability != "" {
+		heading += " · " + styling.CapitalizeResourceName(typechart.NormalizeAbility(ability))
+	}
+	fmt.Fprintf(&out, "Defending as %s (Gen %d)\n", heading, chart.Gen)
+	out.WriteString(styling.StyleBold.Render("Damage Taken:"))
+	out.WriteString("\n")
+
+	multipliers := defenseMultipliers(chart, defending, tera, ability)
+	attackers := slices.Collect(maps.Keys(multipliers))
+	order := append(chart.Types(), typechart.Stellar)
+	sort.Slice(attackers, func(i, j int) bool {
+		return slices.Index(order, attackers[i]) < slices.Index(order, attackers[j])
+	})
+
+	label := lipgloss.NewStyle().Width(6).Bold(true)
+	names := lipgloss.NewStyle().Width(64)
+	for _, tier := range multiplierTiers {
+		var types []string
+		for _, a := range attackers {
+			if multipliers[a] == tier {
+				types = append(types, a)
+			}
+		}
+		if len(types) == 0 {
+			continue
+		}
+		out.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, label.Render(formatMultiplier(tier)), names.Render(coloredTypes(types, ", "))))
+		out.WriteString("\n")
+	}
+
+	return out.String()
+}
+
+// typeCombo is a single or dual typing and the damage it takes from each
+// queried attacking type.
+type typeCombo struct {
+	types       []string
+	multipliers []float64
+}
+
+func (c typeCombo) product() float64 {
+	p := 1.0
+	for _, m := range c.multipliers {
+		p *= m
+	}
+	return p
+}
+
+// resistingCombos lists every single and dual typing that takes ½x or less
+// from each attacking type, the strongest resistances first.
+func resistingCombos(chart *typechart.Chart, attacking []string) []typeCombo {
+	types := chart.Types()
+	var typings [][]string
+	for i, a := range types {
+		typings = append(typings, []string{a})
+		for _, b := range types[i+1:] {
+			typings = append(typings, []string{a, b})
+		}
+	}
+
+	var combos []typeCombo
+	for _, typing := range typings {
+		combo := typeCombo{types: typing}
+		resists := true
+		for _, a := range attacking {
+			m := chart.Effectiveness(a, typing...)
+			if m > 0.5 {
+				resists = false
+				break
+			}
+			combo.multipliers = append(combo.multipliers, m)
+		}
+		if resists {
+			combos = append(combos, combo)
+		}
+	}
+
+	sort.SliceStable(combos, func(i, j int) bool {
+		if pi, pj := combos[i].product(), combos[j].product(); pi != pj {
+			return pi < pj
+		}
+		return len(combos[i].types) < len(combos[j].types)
+	})
+	return combos
+}
+
+func renderResistingCombos(chart *typechart.Chart, attacking []string) string {
+	var out strings.Builder
+
+	combos := resistingCombos(chart, attacking)
+	fmt.Fprintf(&out, "%d typings resist %s (Gen %d)\n", len(combos), coloredTypes(attacking, " and "), chart.Gen)
+	if len(combos) == 0 {
+		return out.String()
+	}
+
+	header := fmt.Sprintf("%-20s", "Typing")
+	for _, a := range attacking {
+		header += fmt.Sprintf("%-10s", styling.CapitalizeResourceName(a))
+	}
+	out.WriteString(styling.StyleBold.Render(strings.TrimRight(header, " ")))
+	out.WriteString("\n")
+
+	for _, c := range combos {
+		typing := coloredTypes(c.types, "/")
+		out.WriteString(typing + strings.Repeat(" ", max(20-lipgloss.Width(typing), 1)))
+		for _, m := range c.multipliers {
+			fmt.Fprintf(&out, "%-10s", formatMultiplier(m))
+		}
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+// typesFlags handles 'poke-cli types [flags]'
+func typesFlags(args []string) (string, error) {
+	var output strings.Builder
+
+	fail := func(msg string) (string, error) {
+		err := fmt.Errorf("%s", utils.FormatError(msg))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	tf := flags.SetupTypesFlagSet()
+	if err := tf.FlagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return output.String(), nil
+		}
+		output.WriteString(utils.FormatFlagError("types", err))
+		return output.String(), err
+	}
+
+	chart, err := typechart.ForGen(*tf.Gen)
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	defending := lowerAll(*tf.Defend)
+	resists := lowerAll(*tf.Resists)
+	tera := strings.ToLower(*tf.Tera)
+	ability := typechart.NormalizeAbility(*tf.Ability)
+	listPokemon := strings.ToLower(strings.TrimSpace(*tf.Pokemon))
+	listMoves := strings.ToLower(strings.TrimSpace(*tf.Moves))
+
+	modes := 0
+	for _, set := range []bool{len(defending) > 0, len(resists) > 0, listPokemon != "", listMoves != ""} {
+		if set {
+			modes++
+		}
+	}
+
+	switch {
+	case tf.FlagSet.NArg() > 0:
+		return fail("types doesn't take arguments with flags")
+	case modes != 1:
+		return fail("Use one of --defend, --resists, --pokemon or --moves")
+	case (*tf.Filter != "" || tf.FlagSet.Changed("page")) && listPokemon == "" && listMoves == "":
+		return fail("--filter and --page only work with --pokemon or --moves")
+	case len(defending) > 2:
+		return fail("--defend takes one or two types")
+	case len(defending) == 2 && defending[0] == defending[1]:
+		return fail("--defend needs two different types")
+	case (tera != "" || ability != "") && len(defending) == 0:
+		return fail("--tera and --ability only work with --defend")
+	}
+
+	if listPokemon != "" || listMoves != "" {
+		return typeListFlag(&output, listPokemon, listMoves, *tf.Filter, *tf.Page)
+	}
+
+	for _, t := range append(slices.Clone(defending), resists...) {
+		if !chart.Has(t, false) {
+			return fail(fmt.Sprintf("Type %q doesn't exist in Gen %d", t, chart.Gen))
+		}
+	}
+	if tera != "" {
+		if chart.Gen < 9 {
+			return fail("Terastallizing needs the Gen 9 chart")
+		}
+		if !chart.Has(tera, true) {
+			return fail(fmt.Sprintf("Invalid Tera type %q", tera))
+		}
+	}
+	if ability != "" && !typechart.HasAbility(ability) {
+		abilities := slices.Sorted(maps.Keys(typechart.AbilityImmunities))
+		abilities = append(abilities, slices.Sorted(maps.Keys(typechart.AbilityResistances))...)
+		return fail(fmt.Sprintf("Ability %q doesn't change type matchups\nChoose from %s", ability, strings.Join(abilities, ", ")))
+	}
+
+	if len(resists) > 0 {
+		output.WriteString(renderResistingCombos(chart, resists))
+		return output.String(), nil
+	}
+	output.WriteString(DefenseBreakdown(chart, defending, tera, ability))
+	return output.String(), nil
+}
+
+// typeListFlag prints a page of the Pokémon or moves of a type.
+func typeListFlag(output *strings.Builder, pokemonType, moveType, filter string, page int) (string, error) {
+	fail := func(msg string) (string, error) {
+		err := fmt.Errorf("%s", utils.FormatError(msg))
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+
+	chart, err := typechart.Latest()
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	fetch, typeName := typePokemonList, pokemonType
+	if moveType != "" {
+		fetch, typeName = typeMoveList, moveType
+	}
+	if !chart.Has(typeName, false) {
+		return fail(fmt.Sprintf("Type %q doesn't exist", typeName))
+	}
+
+	// A filter can match rows on any page, so only an unfiltered list can
+	// skip the details of the other pages
+	fetchPage := page
+	if filter != "" {
+		fetchPage = 0
+	}
+	list, err := fetch(typeName, fetchPage)
+	if err != nil {
+		output.WriteString(err.Error())
+		return output.String(), err
+	}
+	rendered, err := renderListPage(list, filter, page)
+	if err != nil {
+		return fail(err.Error())
+	}
+	output.WriteString(rendered)
+	return output.String(), nil
+}
+
+func lowerAll(values []string) []string {
+	lowered := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+			lowered = append(lowered, v)
+		}
+	}
+	return lowered
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/table"
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/digitalghost-dev/poke-cli/typechart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// breakdownRow finds the line of a defense breakdown for a multiplier.
+func breakdownRow(t *testing.T, out, label string) string {
+	t.Helper()
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, label+" ") {
+			return line
+		}
+	}
+	return ""
+}
+
+func TestDefenseMultipliers(t *testing.T) {
+	chart, err := typechart.Latest()
+	require.NoError(t, err)
+
+	m := defenseMultipliers(chart, []string{"dragon", "flying"}, "", "")
+	assert.Equal(t, 4.0, m["ice"])
+	assert.Equal(t, 0.0, m["ground"])
+	assert.NotContains(t, m, typechart.Stellar, "Stellar only attacks terastallized Pokémon")
+
+	m = defenseMultipliers(chart, []string{"dragon", "flying"}, "steel", "levitate")
+	assert.Equal(t, 0.5, m["ice"])
+	assert.Equal(t, 0.0, m["ground"], "Levitate still applies after terastallizing")
+	assert.Equal(t, 2.0, m[typechart.Stellar])
+
+	m = defenseMultipliers(chart, []string{"water", "dragon"}, "", "thick-fat")
+	assert.Equal(t, 0.125, m["fire"])
+}
+
+func TestDefenseBreakdown(t *testing.T) {
+	chart, err := typechart.Latest()
+	require.NoError(t, err)
+
+	out := styling.StripANSI(DefenseBreakdown(chart, []string{"grass", "steel"}, "", ""))
+	assert.Contains(t, out, "Defending as Grass/Steel (Gen 9)")
+	assert.Contains(t, breakdownRow(t, out, "4x"), "Fire")
+	assert.Contains(t, breakdownRow(t, out, "¼x"), "Grass")
+	assert.Contains(t, breakdownRow(t, out, "0x"), "Poison")
+	assert.Equal(t, "2x    Fighting", strings.TrimSpace(breakdownRow(t, out, "2x")))
+}
+
+func TestResistingCombos(t *testing.T) {
+	chart, err := typechart.Latest()
+	require.NoError(t, err)
+
+	combos := resistingCombos(chart, []string{"fire", "water"})
+	require.NotEmpty(t, combos)
+	assert.Equal(t, []string{"water", "dragon"}, combos[0].types, "the strongest resistance comes first")
+	assert.Equal(t, []float64{0.25, 0.25}, combos[0].multipliers)
+	for _, c := range combos {
+		for _, m := range c.multipliers {
+			assert.LessOrEqual(t, m, 0.5, c.types)
+		}
+	}
+
+	assert.Empty(t, resistingCombos(chart, []string{"normal", "fighting", "ghost", "ground"}))
+}
+
+func TestFormatMultiplier(t *testing.T) {
+	for m, want := range map[float64]string{4: "4x", 2: "2x", 1: "1x", 0.5: "½x", 0.25: "¼x", 0.125: "⅛x", 0: "0x"} {
+		assert.Equal(t, want, formatMultiplier(m))
+	}
+}
+
+func TestTypesCommand_Flags(t *testing.T) {
+	output, err := TypesCommand([]string{"types", "--defend", "Dragon,Flying", "--tera", "stellar"})
+	require.NoError(t, err)
+	out := styling.StripANSI(output)
+	assert.Contains(t, out, "Tera Stellar")
+	assert.Contains(t, breakdownRow(t, out, "4x"), "Ice")
+	assert.Contains(t, breakdownRow(t, out, "2x"), "Stellar")
+
+	output, err = TypesCommand([]string{"types", "-r", "ghost", "-g", "5"})
+	require.NoError(t, err)
+	assert.Contains(t, styling.StripANSI(output), "Steel", "Steel resists Ghost before Gen 6")
+
+	output, err = TypesCommand([]string{"types", "-r", "ghost"})
+	require.NoError(t, err)
+	assert.NotContains(t, styling.StripANSI(output), "\nSteel ")
+}
+
+func TestTypesCommand_FlagErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+	}{
+		{"neither", []string{"types", "-g", "9"}, "one of --defend, --resists, --pokemon or --moves"},
+		{"both", []string{"types", "-d", "fire", "-r", "water"}, "one of --defend, --resists, --pokemon or --moves"},
+		{"three types", []string{"types", "-d", "fire,water,grass"}, "one or two types"},
+		{"same type twice", []string{"types", "-d", "fire,fire"}, "two different types"},
+		{"unknown type", []string{"types", "-d", "sound"}, "doesn't exist"},
+		{"type not in gen", []string{"types", "-d", "fairy", "-g", "5"}, "doesn't exist in Gen 5"},
+		{"tera without defend", []string{"types", "-r", "fire", "-t", "water"}, "only work with --defend"},
+		{"tera before gen 9", []string{"types", "-d", "fire", "-t", "water", "-g", "8"}, "Gen 9"},
+		{"unknown tera", []string{"types", "-d", "fire", "-t", "sound"}, "Invalid Tera type"},
+		{"unknown ability", []string{"types", "-d", "fire", "-a", "intimidate"}, "doesn't change type matchups"},
+		{"bad gen", []string{"types", "-d", "fire", "-g", "10"}, "between 1 and 9"},
+		{"unknown flag", []string{"types", "--attack", "fire"}, "unknown flag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := TypesCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.contains)
+		})
+	}
+}
+
+func TestModel_MarkDualType(t *testing.T) {
+	m := createTestModel()
+	space := tea.KeyPressMsg{Code: tea.KeySpace, Text: " "}
+
+	// Mark Normal, then select Fire
+	nm, _ := m.Update(space)
+	m = nm.(model)
+	assert.Equal(t, []string{"Normal"}, m.marked)
+	assert.Equal(t, table.Row{"Normal ✓"}, m.table.Rows()[0])
+	assert.Contains(t, m.View().Content, "space (mark for a dual type)")
+
+	nm, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	nm, cmd := nm.(model).Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = nm.(model)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, []string{"Normal", "Fire"}, m.selected)
+
+	// Marking again unmarks, and a third mark drops the oldest
+	m = createTestModel()
+	m.toggleMark("Normal")
+	m.toggleMark("Normal")
+	assert.Empty(t, m.marked)
+	m.toggleMark("Normal")
+	m.toggleMark("Fire")
+	m.toggleMark("Water")
+	assert.Equal(t, []string{"Fire", "Water"}, m.marked)
+	assert.Equal(t, table.Row{"Normal"}, m.table.Rows()[0])
+}
@@ -7,18 +7,24 @@ import (
 
 	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/x/term"
+	"github.com/digitalghost-dev/poke-cli/cmd/utils"
 	"github.com/digitalghost-dev/poke-cli/connections"
 	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/digitalghost-dev/poke-cli/typechart"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
 
 // DamageTable Function to build type details after a type is selected
 func DamageTable(typesName string, endpoint string) (string, error) {
-	typesStruct, typeName, err := connections.TypesApiCall(endpoint, typesName, connections.APIURL)
+	chart, err := typechart.Latest()
 	if err != nil {
 		return "", err
 	}
+	typeName := strings.ToLower(typesName)
+	if !chart.Has(typeName, false) {
+		return "", fmt.Errorf("%s", utils.FormatNotFoundError("Type"))
+	}
 
 	// Setting up variables to style the list
 	var columnWidth = 11
@@ -35,7 +41,11 @@ func DamageTable(typesName string, endpoint string) (string, error) {
 	coloredType := lipgloss.NewStyle().Foreground(lipgloss.Color(styling.GetTypeColor(typeName))).Render(selectedType)
 
 	var out strings.Builder
-	fmt.Fprintf(&out, "You selected the %s type.\nNumber of Pokémon with type: %d\nNumber of moves with type: %d\n", coloredType, len(typesStruct.Pokemon), len(typesStruct.Moves))
+	fmt.Fprintf(&out, "You selected the %s type.\n", coloredType)
+	// The damage chart is embedded, only the counts need the API
+	if typesStruct, _, err := connections.TypesApiCall(endpoint, typeName, connections.APIURL); err == nil {
+		fmt.Fprintf(&out, "Number of Pokémon with type: %d\nNumber of moves with type: %d\n", len(typesStruct.Pokemon), len(typesStruct.Moves))
+	}
 	out.WriteString("----------\n")
 	out.WriteString(styling.StyleBold.Render("Damage Chart:"))
 	out.WriteString("\n")
@@ -44,12 +54,12 @@ func DamageTable(typesName string, endpoint string) (string, error) {
 	doc := strings.Builder{}
 
 	// Helper function to build list items
-	buildListItems := func(items []struct{ Name, URL string }) string {
+	buildListItems := func(items []string) string {
 		var itemList []string
 		for _, item := range items {
-			color := styling.GetTypeColor(item.Name)
+			color := styling.GetTypeColor(item)
 			coloredStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
-			coloredItem := coloredStyle.Render(cases.Title(language.English).String(item.Name))
+			coloredItem := coloredStyle.Render(cases.Title(language.English).String(item))
 			itemList = append(itemList, listItem(coloredItem))
 		}
 		return lipgloss.JoinVertical(lipgloss.Left, itemList...)
@@ -60,37 +70,37 @@ func DamageTable(typesName string, endpoint string) (string, error) {
 		list.Width(columnWidth).Render(
 			lipgloss.JoinVertical(lipgloss.Left,
 				listHeader("Weakness"),
-				buildListItems([]struct{ Name, URL string }(typesStruct.DamageRelations.DoubleDamageFrom)),
+				buildListItems(chart.Defending(2, typeName)),
 			),
 		),
 		list.Width(columnWidth).Render(
 			lipgloss.JoinVertical(lipgloss.Left,
 				listHeader("x2 Dmg"),
-				buildListItems([]struct{ Name, URL string }(typesStruct.DamageRelations.DoubleDamageTo)),
+				buildListItems(chart.Attacking(typeName, 2)),
 			),
 		),
 		list.Width(columnWidth).Render(
 			lipgloss.JoinVertical(lipgloss.Left,
 				listHeader("Resists"),
-				buildListItems([]struct{ Name, URL string }(typesStruct.DamageRelations.HalfDamageFrom)),
+				buildListItems(chart.Defending(0.5, typeName)),
 			),
 		),
 		list.Width(columnWidth).Render(
 			lipgloss.JoinVertical(lipgloss.Left,
 				listHeader("x0.5 Dmg"),
-				buildListItems([]struct{ Name, URL string }(typesStruct.DamageRelations.HalfDamageTo)),
+				buildListItems(chart.Attacking(typeName, 0.5)),
 			),
 		),
 		list.Width(columnWidth).Render(
 			lipgloss.JoinVertical(lipgloss.Left,
 				listHeader("Immune"),
-				buildListItems([]struct{ Name, URL string }(typesStruct.DamageRelations.NoDamageFrom)),
+				buildListItems(chart.Defending(0, typeName)),
 			),
 		),
 		list.Width(columnWidth).Render(
 			lipgloss.JoinVertical(lipgloss.Left,
 				listHeader("x0 Dmg"),
-				buildListItems([]struct{ Name, URL string }(typesStruct.DamageRelations.NoDamageTo)),
+				buildListItems(chart.Attacking(typeName, 0)),
 			),
 		),
 	)
@@ -0,0 +1,425 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/table"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	ltable "charm.land/lipgloss/v2/table"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+const (
+	// listPageSize is how many rows one page of a type list shows.
+	listPageSize = 20
+	// listWorkers caps the concurrent API calls that fill in a type list.
+	listWorkers = 8
+)
+
+var (
+	typesApiCall   = connections.TypesApiCall
+	pokemonApiCall = connections.PokemonApiCall
+	moveApiCall    = connections.MoveApiCall
+)
+
+// typeList is the table of Pokémon or moves that have a type.
+type typeList struct {
+	title   string
+	headers []string
+	widths  []int
+	rows    [][]string
+	// failed counts the entries whose details couldn't be fetched.
+	failed int
+}
+
+// fetchEach calls fetch for every name with up to listWorkers calls at once.
+// Results keep the order of names; a failed call leaves its zero value.
+func fetchEach[T any](names []string, fetch func(string) (T, error)) ([]T, []error) {
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, listWorkers)
+		results = make([]T, len(names))
+		errs    = make([]error, len(names))
+	)
+
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = fetch(name)
+		}(i, name)
+	}
+
+	wg.Wait()
+	return results, errs
+}
+
+// orDash writes 0 as "—", for the power and accuracy of status moves.
+func orDash(n int) string {
+	if n == 0 {
+		return "—"
+	}
+	return strconv.Itoa(n)
+}
+
+// fillRows adds a row for each name with the details fetch returns. page
+// above 0 only fetches the rows on that page, since those are all a page
+// shows; the rest show "?" without counting as failed.
+func (l *typeList) fillRows(names []string, page int, fetch func(string) ([]string, error)) {
+	start, end := 0, len(names)
+	if page > 0 {
+		start = min((page-1)*listPageSize, len(names))
+		end = min(start+listPageSize, len(names))
+	}
+	details, errs := fetchEach(names[start:end], fetch)
+
+	for i, name := range names {
+		row := []string{styling.CapitalizeResourceName(name)}
+		fetched := i >= start && i < end
+		if fetched && errs[i-start] == nil {
+			row = append(row, details[i-start]...)
+		} else {
+			if fetched {
+				l.failed++
+			}
+			for len(row) < len(l.headers) {
+				row = append(row, "?")
+			}
+		}
+		l.rows = append(l.rows, row)
+	}
+}
+
+// typePokemonList lists the Pokémon of a type with their other type and base
+// stat total, fetching the details of every row or only those on page.
+func typePokemonList(typeName string, page int) (typeList, error) {
+	typesStruct, _, err := typesApiCall("type", typeName, connections.APIURL)
+	if err != nil {
+		return typeList{}, err
+	}
+
+	names := make([]string, len(typesStruct.Pokemon))
+	for i, p := range typesStruct.Pokemon {
+		names[i] = p.Pokemon.Name
+	}
+
+	list := typeList{
+		title:   styling.CapitalizeResourceName(typeName) + " Pokémon",
+		headers: []string{"Pokémon", "Other Type", "BST"},
+		widths:  []int{26, 12, 6},
+	}
+	list.fillRows(names, page, func(name string) ([]string, error) {
+		pokemonStruct, _, err := pokemonApiCall("pokemon", name, connections.APIURL)
+		if err != nil {
+			return nil, err
+		}
+
+		other := "—"
+		for _, t := range pokemonStruct.Types {
+			if t.Type.Name != typeName {
+				other = styling.CapitalizeResourceName(t.Type.Name)
+			}
+		}
+		bst := 0
+		for _, s := range pokemonStruct.Stats {
+			bst += s.BaseStat
+		}
+		return []string{other, strconv.Itoa(bst)}, nil
+	})
+	return list, nil
+}
+
+// typeMoveList lists the moves of a type with their power, accuracy and
+// category, fetching the details of every row or only those on page.
+func typeMoveList(typeName string, page int) (typeList, error) {
+	typesStruct, _, err := typesApiCall("type", typeName, connections.APIURL)
+	if err != nil {
+		return typeList{}, err
+	}
+
+	names := make([]string, len(typesStruct.Moves))
+	for i, m := range typesStruct.Moves {
+		names[i] = m.Name
+	}
+
+	list := typeList{
+		title:   styling.CapitalizeResourceName(typeName) + " Moves",
+		headers: []string{"Move", "Power", "Accuracy", "Category"},
+		widths:  []int{26, 7, 10, 10},
+	}
+	list.fillRows(names, page, func(name string) ([]string, error) {
+		moveStruct, _, err := moveApiCall("move", name, connections.APIURL)
+		if err != nil {
+			return nil, err
+		}
+		return []string{
+			orDash(moveStruct.Power),
+			orDash(moveStruct.Accuracy),
+			styling.CapitalizeResourceName(moveStruct.DamageClass.Name),
+		}, nil
+	})
+	return list, nil
+}
+
+// filterRows keeps the rows where any column contains the query, ignoring case.
+func filterRows(rows [][]string, query string) [][]string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return rows
+	}
+
+	var filtered [][]string
+	for _, row := range rows {
+		for _, col := range row {
+			if strings.Contains(strings.ToLower(col), query) {
+				filtered = append(filtered, row)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// pageCount is the number of pages n rows fill, at least one.
+func pageCount(n int) int {
+	return max(1, (n+listPageSize-1)/listPageSize)
+}
+
+// renderListPage prints one page of a type list for the --pokemon and --moves flags.
+func renderListPage(list typeList, filter string, page int) (string, error) {
+	rows := filterRows(list.rows, filter)
+	pages := pageCount(len(rows))
+	if page < 1 || page > pages {
+		return "", fmt.Errorf("page %d doesn't exist, %s has %d", page, list.title, pages)
+	}
+
+	var out strings.Builder
+	summary := fmt.Sprintf("%s · %d", list.title, len(list.rows))
+	if filter != "" {
+		summary = fmt.Sprintf("%s · %d of %d match %q", list.title, len(rows), len(list.rows), filter)
+	}
+	fmt.Fprintf(&out, "%s · page %d/%d\n", styling.StyleBold.Render(summary), page, pages)
+
+	start := (page - 1) * listPageSize
+	end := min(start+listPageSize, len(rows))
+
+	isDark := styling.HasDarkBackground()
+	ld := lipgloss.LightDark(isDark)
+	color := ld(lipgloss.Color("#4B4B4B"), lipgloss.Color("#D3D3D3"))
+
+	t := ltable.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(color)).
+		StyleFunc(func(row, column int) lipgloss.Style {
+			return lipgloss.NewStyle().Width(list.widths[column])
+		}).
+		Headers(list.headers...).
+		Rows(rows[start:end]...)
+	out.WriteString(t.String())
+	out.WriteString("\n")
+
+	if list.failed > 0 {
+		fmt.Fprintf(&out, "%d entries couldn't be loaded and show ?\n", list.failed)
+	}
+	if page < pages {
+		fmt.Fprintf(&out, "Use --page %d for more.\n", page+1)
+	}
+	return out.String(), nil
+}
+
+// listModel browses a type list in the types TUI.
+type listModel struct {
+	err      error
+	list     typeList
+	loading  bool
+	quitting bool
+	search   textinput.Model
+	spinner  spinner.Model
+	table    table.Model
+	typeName string
+	wantMove bool
+}
+
+// listDataMsg carries a fetched type list back to Update().
+type listDataMsg struct {
+	list typeList
+	err  error
+}
+
+func newListModel(typeName string, moves bool) listModel {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = styling.Theme
+
+	return listModel{typeName: typeName, wantMove: moves, loading: true, spinner: s}
+}
+
+func (m listModel) fetchCmd() tea.Cmd {
+	return func() tea.Msg {
+		fetch := typePokemonList
+		if m.wantMove {
+			fetch = typeMoveList
+		}
+		// The list can be filtered, so every row needs its details
+		list, err := fetch(m.typeName, 0)
+		return listDataMsg{list: list, err: err}
+	}
+}
+
+func (m listModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.fetchCmd())
+}
+
+func (m listModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var bubbleCmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			m.quitting = true
+			return m, tea.Quit
+		case "esc":
+			// If in the search bar, exit search mode instead of quitting.
+			if m.search.Focused() {
+				m.search.Blur()
+				m.table.Focus()
+				return m, nil
+			}
+			m.quitting = true
+			return m, tea.Quit
+		case "tab":
+			if m.loading || m.err != nil {
+				return m, nil
+			}
+			if m.search.Focused() {
+				m.search.Blur()
+				m.table.Focus()
+			} else {
+				m.table.Blur()
+				m.search.Focus()
+			}
+			return m, nil
+		}
+
+	case listDataMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+
+		columns := make([]table.Column, len(msg.list.headers))
+		width := 0
+		for i, h := range msg.list.headers {
+			columns[i] = table.Column{Title: h, Width: msg.list.widths[i]}
+			width += msg.list.widths[i] + 2
+		}
+		ti := textinput.New()
+		ti.Placeholder = "filter by any column..."
+		ti.Prompt = "🔎 "
+		ti.CharLimit = 24
+		ti.SetWidth(30)
+
+		t := table.New(
+			table.WithColumns(columns),
+			table.WithRows(tableRows(msg.list.rows)),
+			table.WithFocused(true),
+			table.WithHeight(listPageSize),
+			table.WithWidth(width),
+		)
+		s := table.DefaultStyles()
+		s.Header = s.Header.
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(styling.ThemeColor).
+			BorderBottom(true)
+		s.Selected = s.Selected.
+			Foreground(styling.ContrastText(styling.ThemeColor)).
+			Background(styling.ThemeColor)
+		t.SetStyles(s)
+
+		m.list = msg.list
+		m.search = ti
+		m.table = t
+		return m, nil
+
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+
+	if m.loading || m.err != nil {
+		return m, nil
+	}
+	if m.search.Focused() {
+		prev := m.search.Value()
+		m.search, bubbleCmd = m.search.Update(msg)
+		if m.search.Value() != prev {
+			m.applyFilter()
+		}
+		return m, bubbleCmd
+	}
+	m.table, bubbleCmd = m.table.Update(msg)
+	return m, bubbleCmd
+}
+
+func tableRows(rows [][]string) []table.Row {
+	converted := make([]table.Row, len(rows))
+	for i, r := range rows {
+		converted[i] = r
+	}
+	return converted
+}
+
+func (m *listModel) applyFilter() {
+	m.table.SetRows(tableRows(filterRows(m.list.rows, m.search.Value())))
+	m.table.SetCursor(0)
+}
+
+func (m listModel) View() tea.View {
+	var content string
+	switch {
+	case m.quitting:
+		content = "\n  Goodbye! \n"
+	case m.err != nil:
+		content = styling.ApiErrorStyle.Render(
+			"Error loading the " + styling.CapitalizeResourceName(m.typeName) + " type:\n" +
+				m.err.Error() + "\n\n" +
+				"Press ctrl+c or esc to exit.",
+		)
+	case m.loading:
+		what := "Pokémon"
+		if m.wantMove {
+			what = "moves"
+		}
+		content = lipgloss.NewStyle().Padding(2).Render(
+			m.spinner.View() + " Loading " + what + "...",
+		)
+	default:
+		rows := len(m.table.Rows())
+		page := m.table.Cursor()/listPageSize + 1
+		header := fmt.Sprintf("%s · %d of %d · page %d/%d", m.list.title, rows, len(m.list.rows), page, pageCount(rows))
+		if m.list.failed > 0 {
+			header += fmt.Sprintf("\n%d entries couldn't be loaded and show ?", m.list.failed)
+		}
+		content = fmt.Sprintf("%s\n%s\n%s",
+			styling.StyleBold.Render(header),
+			styling.TypesTableBorder.Render(lipgloss.JoinVertical(lipgloss.Left, m.search.View(), m.table.View())),
+			styling.KeyMenu.Render("↑ (move up) • ↓ (move down) • pgup/pgdown (page)\ntab (toggle filter) • ctrl+c | esc (quit)"))
+	}
+
+	return tea.NewView(content)
+}
@@ -0,0 +1,291 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTypeAPI replaces the API calls with a dragon type of three Pokémon and
+// three moves. Garchomp and Outrage fail to load.
+func stubTypeAPI(t *testing.T) {
+	t.Helper()
+	origTypes, origPokemon, origMove := typesApiCall, pokemonApiCall, moveApiCall
+	t.Cleanup(func() {
+		typesApiCall, pokemonApiCall, moveApiCall = origTypes, origPokemon, origMove
+	})
+
+	typesApiCall = func(endpoint, name, baseURL string) (structs.TypesJSONStruct, string, error) {
+		var s structs.TypesJSONStruct
+		err := json.Unmarshal([]byte(`{
+			"name": "dragon",
+			"pokemon": [
+				{"pokemon": {"name": "dragonite"}, "slot": 1},
+				{"pokemon": {"name": "garchomp"}, "slot": 1},
+				{"pokemon": {"name": "dratini"}, "slot": 1}
+			],
+			"moves": [{"name": "dragon-claw"}, {"name": "outrage"}, {"name": "dragon-dance"}]
+		}`), &s)
+		return s, name, err
+	}
+	pokemonApiCall = func(endpoint, name, baseURL string) (structs.PokemonJSONStruct, string, error) {
+		var s structs.PokemonJSONStruct
+		pokemon := map[string]string{
+			"dragonite": `{"types": [{"slot": 1, "type": {"name": "dragon"}}, {"slot": 2, "type": {"name": "flying"}}],
+				"stats": [{"base_stat": 91}, {"base_stat": 134}, {"base_stat": 95}, {"base_stat": 100}, {"base_stat": 100}, {"base_stat": 80}]}`,
+			"dratini": `{"types": [{"slot": 1, "type": {"name": "dragon"}}],
+				"stats": [{"base_stat": 41}, {"base_stat": 64}, {"base_stat": 45}, {"base_stat": 50}, {"base_stat": 50}, {"base_stat": 50}]}`,
+		}
+		body, ok := pokemon[name]
+		if !ok {
+			return s, name, errors.New("not found")
+		}
+		return s, name, json.Unmarshal([]byte(body), &s)
+	}
+	moveApiCall = func(endpoint, name, baseURL string) (structs.MoveJSONStruct, string, error) {
+		var s structs.MoveJSONStruct
+		moves := map[string]string{
+			"dragon-claw":  `{"power": 80, "accuracy": 100, "damage_class": {"name": "physical"}}`,
+			"dragon-dance": `{"power": null, "accuracy": null, "damage_class": {"name": "status"}}`,
+		}
+		body, ok := moves[name]
+		if !ok {
+			return s, name, errors.New("not found")
+		}
+		return s, name, json.Unmarshal([]byte(body), &s)
+	}
+}
+
+func TestTypePokemonList(t *testing.T) {
+	stubTypeAPI(t)
+
+	list, err := typePokemonList("dragon", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "Dragon Pokémon", list.title)
+	assert.Equal(t, [][]string{
+		{"Dragonite", "Flying", "600"},
+		{"Garchomp", "?", "?"},
+		{"Dratini", "—", "300"},
+	}, list.rows)
+	assert.Equal(t, 1, list.failed)
+}
+
+func TestTypeMoveList(t *testing.T) {
+	stubTypeAPI(t)
+
+	list, err := typeMoveList("dragon", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Move", "Power", "Accuracy", "Category"}, list.headers)
+	assert.Equal(t, [][]string{
+		{"Dragon Claw", "80", "100", "Physical"},
+		{"Outrage", "?", "?", "?"},
+		{"Dragon Dance", "—", "—", "Status"},
+	}, list.rows)
+	assert.Equal(t, 1, list.failed)
+}
+
+func TestTypePokemonList_Page(t *testing.T) {
+	stubTypeAPI(t)
+	typesApiCall = func(endpoint, name, baseURL string) (structs.TypesJSONStruct, string, error) {
+		var s structs.TypesJSONStruct
+		for i := range 45 {
+			entry := struct {
+				Pokemon struct {
+					Name string `json:"name"`
+					URL  string `json:"url"`
+				} `json:"pokemon"`
+				Slot int `json:"slot"`
+			}{}
+			entry.Pokemon.Name = fmt.Sprintf("mon-%02d", i)
+			s.Pokemon = append(s.Pokemon, entry)
+		}
+		return s, name, nil
+	}
+	var calls atomic.Int32
+	pokemonApiCall = func(endpoint, name, baseURL string) (structs.PokemonJSONStruct, string, error) {
+		calls.Add(1)
+		return structs.PokemonJSONStruct{}, name, nil
+	}
+
+	list, err := typePokemonList("dragon", 2)
+	require.NoError(t, err)
+	assert.Equal(t, int32(listPageSize), calls.Load(), "only page 2 is fetched")
+	assert.Len(t, list.rows, 45)
+	assert.Equal(t, []string{"Mon 19", "?", "?"}, list.rows[19])
+	assert.Equal(t, []string{"Mon 20", "—", "0"}, list.rows[20])
+	assert.Zero(t, list.failed, "rows off the page aren't failures")
+
+	calls.Store(0)
+	_, err = typePokemonList("dragon", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(45), calls.Load())
+
+	calls.Store(0)
+	output, err := TypesCommand([]string{"types", "-p", "dragon", "--page", "3"})
+	require.NoError(t, err, output)
+	assert.Equal(t, int32(5), calls.Load())
+
+	calls.Store(0)
+	_, err = TypesCommand([]string{"types", "-p", "dragon", "-f", "mon", "--page", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(45), calls.Load(), "a filter needs every row")
+}
+
+func TestFetchEach_KeepsOrder(t *testing.T) {
+	names := make([]string, 50)
+	for i := range names {
+		names[i] = fmt.Sprint(i)
+	}
+
+	results, errs := fetchEach(names, func(name string) (string, error) {
+		if name == "7" {
+			return "", errors.New("boom")
+		}
+		return "#" + name, nil
+	})
+	assert.Equal(t, "#0", results[0])
+	assert.Equal(t, "#49", results[49])
+	assert.Empty(t, results[7])
+	assert.Error(t, errs[7])
+	assert.NoError(t, errs[8])
+}
+
+func TestFilterRows(t *testing.T) {
+	rows := [][]string{{"Dragonite", "Flying"}, {"Dratini", "—"}, {"Kingdra", "Water"}}
+
+	assert.Equal(t, rows, filterRows(rows, ""))
+	assert.Equal(t, [][]string{{"Dragonite", "Flying"}}, filterRows(rows, " FLY "))
+	assert.Equal(t, [][]string{{"Dragonite", "Flying"}, {"Dratini", "—"}, {"Kingdra", "Water"}}, filterRows(rows, "dra"))
+	assert.Empty(t, filterRows(rows, "fairy"))
+}
+
+func TestRenderListPage(t *testing.T) {
+	list := typeList{title: "Fire Moves", headers: []string{"Move"}, widths: []int{10}}
+	for i := range 45 {
+		list.rows = append(list.rows, []string{fmt.Sprintf("Move %02d", i)})
+	}
+
+	out, err := renderListPage(list, "", 1)
+	require.NoError(t, err)
+	out = styling.StripANSI(out)
+	assert.Contains(t, out, "Fire Moves · 45 · page 1/3")
+	assert.Contains(t, out, "Move 19")
+	assert.NotContains(t, out, "Move 20")
+	assert.Contains(t, out, "Use --page 2 for more.")
+
+	out, err = renderListPage(list, "", 3)
+	require.NoError(t, err)
+	out = styling.StripANSI(out)
+	assert.Contains(t, out, "Move 44")
+	assert.NotContains(t, out, "--page")
+
+	out, err = renderListPage(list, "move 1", 1)
+	require.NoError(t, err)
+	assert.Contains(t, styling.StripANSI(out), `10 of 45 match "move 1" · page 1/1`)
+
+	_, err = renderListPage(list, "", 4)
+	assert.EqualError(t, err, "page 4 doesn't exist, Fire Moves has 3")
+}
+
+func TestTypesCommand_ListFlags(t *testing.T) {
+	stubTypeAPI(t)
+
+	output, err := TypesCommand([]string{"types", "--pokemon", "Dragon", "-f", "flying"})
+	require.NoError(t, err)
+	out := styling.StripANSI(output)
+	assert.Contains(t, out, `Dragon Pokémon · 1 of 3 match "flying"`)
+	assert.Contains(t, out, "Dragonite")
+	assert.NotContains(t, out, "Dratini")
+	assert.Contains(t, out, "1 entries couldn't be loaded")
+
+	output, err = TypesCommand([]string{"types", "-m", "dragon"})
+	require.NoError(t, err)
+	assert.Contains(t, styling.StripANSI(output), "Dragon Dance")
+
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+	}{
+		{"two lists", []string{"types", "-p", "dragon", "-m", "dragon"}, "Use one of"},
+		{"list with defend", []string{"types", "-p", "dragon", "-d", "fire"}, "Use one of"},
+		{"filter without list", []string{"types", "-d", "fire", "-f", "x"}, "--filter and --page only work"},
+		{"page without list", []string{"types", "-r", "fire", "--page", "2"}, "--filter and --page only work"},
+		{"unknown type", []string{"types", "-p", "sound"}, `Type "sound" doesn't exist`},
+		{"page out of range", []string{"types", "-m", "dragon", "--page", "2"}, "page 2 doesn't exist"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := TypesCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, styling.StripANSI(output), tt.contains)
+		})
+	}
+}
+
+func TestModel_ListKeys(t *testing.T) {
+	m := createTestModel()
+	nm, _ := m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	nm, cmd := nm.(model).Update(tea.KeyPressMsg{Code: 'm', Text: "m"})
+	m = nm.(model)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "Fire", m.selectedOption)
+	assert.Equal(t, "moves", m.list)
+
+	nm, _ = createTestModel().Update(tea.KeyPressMsg{Code: 'p', Text: "p"})
+	assert.Equal(t, "pokemon", nm.(model).list)
+}
+
+func TestListModel(t *testing.T) {
+	stubTypeAPI(t)
+
+	m := newListModel("dragon", false)
+	assert.Contains(t, m.View().Content, "Loading Pokémon...")
+
+	msg := m.fetchCmd()()
+	nm, _ := m.Update(msg)
+	m = nm.(listModel)
+	assert.False(t, m.loading)
+	require.Len(t, m.table.Rows(), 3)
+
+	view := styling.StripANSI(m.View().Content)
+	assert.Contains(t, view, "Dragon Pokémon · 3 of 3 · page 1/1")
+	assert.Contains(t, view, "1 entries couldn't be loaded")
+	assert.Contains(t, view, "tab (toggle filter)")
+
+	// Tab into the filter and type a query
+	nm, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	m = nm.(listModel)
+	assert.True(t, m.search.Focused())
+	for _, r := range "fly" {
+		nm, _ = m.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+		m = nm.(listModel)
+	}
+	require.Len(t, m.table.Rows(), 1)
+	assert.Equal(t, "Dragonite", m.table.Rows()[0][0])
+
+	// Esc leaves the filter first, then quits
+	nm, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = nm.(listModel)
+	assert.False(t, m.search.Focused())
+	assert.False(t, m.quitting)
+	nm, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.True(t, nm.(listModel).quitting)
+	assert.NotNil(t, cmd)
+}
+
+func TestListModel_Error(t *testing.T) {
+	m := newListModel("dragon", true)
+	nm, _ := m.Update(listDataMsg{err: errors.New("offline")})
+	view := styling.StripANSI(nm.(listModel).View().Content)
+	assert.Contains(t, view, "offline")
+	assert.Contains(t, view, "Press ctrl+c or esc to exit.")
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"charm.land/bubbles/v2/table"
@@ -9,6 +10,7 @@ import (
 	"charm.land/lipgloss/v2"
 	"github.com/digitalghost-dev/poke-cli/cmd/utils"
 	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/digitalghost-dev/poke-cli/typechart"
 )
 
 func TypesCommand(args []string) (string, error) {
@@ -18,8 +20,19 @@ func TypesCommand(args []string) (string, error) {
 		output.WriteString(
 			utils.GenerateHelpMessage(
 				utils.HelpConfig{
-					Description: "Get details about a specific typing.",
+					Description: "Get details about a specific typing, or the matchups of a dual or Tera typing.",
 					CmdName:     "types",
+					Flags: []utils.FlagHelp{
+						{Short: "-d", Long: "--defend", Description: "One or two defending types, e.g. dragon,flying."},
+						{Short: "-t", Long: "--tera", Description: "With --defend, the Tera type, including stellar."},
+						{Short: "-a", Long: "--ability", Description: "With --defend, an ability such as levitate or thick-fat."},
+						{Short: "-r", Long: "--resists", Description: "List the type combos that resist all of these types."},
+						{Short: "-g", Long: "--gen", Description: "Generation of the type chart, from 1 to 9."},
+						{Short: "-p", Long: "--pokemon", Description: "List the Pokémon of a type with their other type and BST."},
+						{Short: "-m", Long: "--moves", Description: "List the moves of a type with power, accuracy and category."},
+						{Short: "-f", Long: "--filter", Description: "With --pokemon or --moves, only rows containing this text."},
+						{Long: "--page", Description: "With --pokemon or --moves, the page to show."},
+					},
 				},
 			),
 		)
@@ -29,6 +42,10 @@ func TypesCommand(args []string) (string, error) {
 		return output.String(), nil
 	}
 
+	if len(args) > 1 && strings.HasPrefix(args[1], "-") {
+		return typesFlags(args[1:])
+	}
+
 	// Validate arguments
 	if err := utils.ValidateArgs(
 		args,
@@ -53,6 +70,11 @@ type model struct {
 	quitting       bool
 	table          table.Model
 	selectedOption string
+	// marked holds up to two types picked with space for a dual typing.
+	marked   []string
+	selected []string
+	// list is "pokemon" or "moves" when the user asked for the type's list.
+	list string
 }
 
 // Init initializes the model
@@ -70,9 +92,22 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "esc", "ctrl+c":
 			m.quitting = true
 			return m, tea.Quit
+		case "space":
+			m.toggleMark(rowType(m.table.SelectedRow()))
+			return m, nil
+		case "p", "m":
+			m.selectedOption = rowType(m.table.SelectedRow())
+			m.selected = []string{m.selectedOption}
+			m.list = map[string]string{"p": "pokemon", "m": "moves"}[msg.String()]
+			return m, tea.Quit
 		case "enter":
 			// User selected a type
-			m.selectedOption = m.table.SelectedRow()[0]
+			current := rowType(m.table.SelectedRow())
+			m.selectedOption = current
+			m.selected = slices.Clone(m.marked)
+			if len(m.selected) < 2 && !slices.Contains(m.selected, current) {
+				m.selected = append(m.selected, current)
+			}
 			return m, tea.Quit
 		}
 	}
@@ -96,7 +131,37 @@ func (m model) View() tea.View {
 	// Render the type selection table with instructions
 	return tea.NewView(fmt.Sprintf("Select a type!\n%s\n%s",
 		styling.TypesTableBorder.Render(m.table.View()),
-		styling.KeyMenu.Render("↑ (move up) • ↓ (move down)\nenter (select) • ctrl+c | esc (quit)")))
+		styling.KeyMenu.Render("↑ (move up) • ↓ (move down) • space (mark for a dual type)\nenter (select) • p (Pokémon) • m (moves) • ctrl+c | esc (quit)")))
+}
+
+// rowType is the type in a table row, without its mark.
+func rowType(row table.Row) string {
+	if len(row) == 0 {
+		return ""
+	}
+	return strings.TrimSuffix(row[0], " ✓")
+}
+
+// toggleMark marks or unmarks a type. Marking a third type replaces the oldest.
+func (m *model) toggleMark(name string) {
+	if i := slices.Index(m.marked, name); i >= 0 {
+		m.marked = slices.Delete(slices.Clone(m.marked), i, i+1)
+	} else {
+		m.marked = append(slices.Clone(m.marked), name)
+		if len(m.marked) > 2 {
+			m.marked = m.marked[1:]
+		}
+	}
+
+	rows := slices.Clone(m.table.Rows())
+	for i, row := range rows {
+		name := rowType(row)
+		if slices.Contains(m.marked, name) {
+			name += " ✓"
+		}
+		rows[i] = table.Row{name}
+	}
+	m.table.SetRows(rows)
 }
 
 func createTypeSelectionTable() model {
@@ -139,7 +204,21 @@ func runTypeSelectionTable(endpoint string) (string, error) {
 	}
 
 	if finalModel, ok := programModel.(model); ok && finalModel.selectedOption != "" {
-		return DamageTable(strings.ToLower(finalModel.selectedOption), endpoint)
+		if finalModel.list != "" {
+			listModel := newListModel(strings.ToLower(finalModel.selectedOption), finalModel.list == "moves")
+			if _, err := tea.NewProgram(listModel).Run(); err != nil {
+				return "", fmt.Errorf("error running program: %w", err)
+			}
+			return "", nil
+		}
+		if len(finalModel.selected) == 2 {
+			chart, err := typechart.Latest()
+			if err != nil {
+				return "", err
+			}
+			return DefenseBreakdown(chart, lowerAll(finalModel.selected), "", ""), nil
+		}
+		return DamageTable(strings.ToLower(finalModel.selected[0]), endpoint)
 	}
 
 	return "", nil
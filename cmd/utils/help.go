@@ -23,7 +23,11 @@ type FlagHelp struct {
 func GenerateHelpMessage(cfg HelpConfig) string {
 	var flagsBuilder strings.Builder
 	for _, f := range cfg.Flags {
-		fmt.Fprintf(&flagsBuilder, "\n\t%-30s %s", f.Short+", "+f.Long, f.Description)
+		name := f.Long
+		if f.Short != "" {
+			name = f.Short + ", " + f.Long
+		}
+		fmt.Fprintf(&flagsBuilder, "\n\t%-30s %s", name, f.Description)
 	}
 	flagsList := flagsBuilder.String()
 
@@ -192,6 +192,11 @@ func TestQueryBerryDataWithVariadicArgs(t *testing.T) {
 }
 
 func TestEmbeddedDBExists(t *testing.T) {
+	embeddedDB, err := datasets.ReadFile("db/" + string(Berries))
+	if err != nil {
+		t.Fatalf("failed to read embedded database: %v", err)
+	}
+
 	if len(embeddedDB) == 0 {
 		t.Error("embeddedDB should not be empty")
 	}
@@ -172,6 +172,42 @@ func TestAbilityApiCall(t *testing.T) {
 	})
 }
 
+func TestEvolutionChainApiCall(t *testing.T) {
+	t.Run("Successful API call returns expected chain", func(t *testing.T) {
+		expectedChain := structs.EvolutionChainJSONStruct{ID: 46}
+		expectedChain.Chain.Species.Name = "gastly"
+		haunter := structs.EvolutionLink{}
+		haunter.Species.Name = "haunter"
+		expectedChain.Chain.EvolvesTo = []structs.EvolutionLink{haunter}
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/evolution-chain/46", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(expectedChain)
+			assert.NoError(t, err, "Expected no error for encoding response")
+		}))
+		defer ts.Close()
+
+		chain, name, err := EvolutionChainApiCall("/evolution-chain", "46", ts.URL)
+
+		require.NoError(t, err, "Expected no error on successful API call")
+		assert.Equal(t, expectedChain, chain, "Expected chain struct does not match")
+		assert.Equal(t, "gastly", name, "Expected the chain's first species")
+	})
+
+	t.Run("Failed API call returns styled error", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}))
+		defer ts.Close()
+
+		_, _, err := EvolutionChainApiCall("/evolution-chain", "0", ts.URL)
+
+		require.Error(t, err, "Expected an error for invalid chain")
+		assert.Contains(t, err.Error(), "Evolution chain not found", "Expected 'Evolution chain not found' in error message")
+	})
+}
+
 func TestItemApiCall(t *testing.T) {
 	t.Run("Successful API call returns expected item", func(t *testing.T) {
 		expectedItem := structs.ItemJSONStruct{
@@ -210,6 +246,40 @@ func TestItemApiCall(t *testing.T) {
 	})
 }
 
+func TestMachineApiCall(t *testing.T) {
+	t.Run("Successful API call returns expected machine", func(t *testing.T) {
+		expectedMachine := structs.MachineJSONStruct{ID: 1688}
+		expectedMachine.Item.Name = "tm24"
+		expectedMachine.Move.Name = "thunderbolt"
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/machine/1688", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(expectedMachine)
+			assert.NoError(t, err, "Expected no error for encoding response")
+		}))
+		defer ts.Close()
+
+		machine, name, err := MachineApiCall("/machine", "1688", ts.URL)
+
+		require.NoError(t, err, "Expected no error on successful API call")
+		assert.Equal(t, expectedMachine, machine, "Expected machine struct does not match")
+		assert.Equal(t, "tm24", name, "Expected the machine's item name")
+	})
+
+	t.Run("Failed API call returns styled error", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}))
+		defer ts.Close()
+
+		_, _, err := MachineApiCall("/machine", "0", ts.URL)
+
+		require.Error(t, err, "Expected an error for invalid machine")
+		assert.Contains(t, err.Error(), "Machine not found", "Expected 'Machine not found' in error message")
+	})
+}
+
 func TestMoveApiCall(t *testing.T) {
 	t.Run("Successful API call returns expected move", func(t *testing.T) {
 		expectedMove := structs.MoveJSONStruct{
@@ -420,3 +490,29 @@ func TestCallTCGData(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestCallWithHeaders(t *testing.T) {
+	t.Run("sends only the given headers", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			assert.Empty(t, r.Header.Get("apikey"))
+			_, _ = w.Write([]byte(`[]`))
+		}))
+		defer srv.Close()
+
+		body, err := CallWithHeaders(srv.URL, map[string]string{"Authorization": "Bearer secret"})
+		require.NoError(t, err)
+		assert.Equal(t, `[]`, string(body))
+	})
+
+	t.Run("returns error for non-200 status", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer srv.Close()
+
+		_, err := CallWithHeaders(srv.URL, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected status code: 401")
+	})
+}
@@ -0,0 +1,182 @@
+package connections
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"reflect"
+	"sync"
+
+	_ "modernc.org/sqlite"
+	"modernc.org/sqlite/vfs"
+)
+
+// Dataset names an SQLite database embedded under db/.
+type Dataset string
+
+const (
+	Berries   Dataset = "berries.db"
+	TypeChart Dataset = "typechart.db"
+)
+
+//go:embed db/*.db
+var datasets embed.FS
+
+var (
+	vfsOnce sync.Once
+	vfsName string
+	vfsErr  error
+
+	handlesMu sync.Mutex
+	handles   = map[Dataset]*sql.DB{}
+)
+
+// Open returns the shared read-only handle for an embedded dataset. The
+// database is read straight from the binary through an SQLite VFS, so it is
+// opened once per process and never copied to disk.
+func Open(ds Dataset) (*sql.DB, error) {
+	vfsOnce.Do(func() {
+		vfsName, _, vfsErr = vfs.New(datasets)
+	})
+	if vfsErr != nil {
+		return nil, fmt.Errorf("failed to register embedded databases: %w", vfsErr)
+	}
+
+	handlesMu.Lock()
+	defer handlesMu.Unlock()
+
+	if db, ok := handles[ds]; ok {
+		return db, nil
+	}
+	if _, err := datasets.Open("db/" + string(ds)); err != nil {
+		return nil, fmt.Errorf("unknown dataset %q", ds)
+	}
+
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:db/%s?vfs=%s&mode=ro", ds, vfsName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	handles[ds] = db
+	return db, nil
+}
+
+// Query runs a query against an embedded dataset and scans every row into a T.
+// A struct T is filled column by column through its `db` tags; any other T
+// scans a single column. NULL columns leave the field at its zero value.
+func Query[T any](ds Dataset, query string, args ...any) ([]T, error) {
+	db, err := Open(ds)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", ds, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []T{}
+	for rows.Next() {
+		var row T
+		if err := scanRow(rows, columns, reflect.ValueOf(&row).Elem()); err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", ds, err)
+		}
+		results = append(results, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return results, nil
+}
+
+func scanRow(rows *sql.Rows, columns []string, dest reflect.Value) error {
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	if err := rows.Scan(pointers...); err != nil {
+		return err
+	}
+
+	if dest.Kind() != reflect.Struct {
+		if len(columns) != 1 {
+			return fmt.Errorf("%d columns can't scan into %s", len(columns), dest.Type())
+		}
+		return assign(dest, values[0])
+	}
+
+	fields := map[string]int{}
+	for i := range dest.NumField() {
+		if tag := dest.Type().Field(i).Tag.Get("db"); tag != "" {
+			fields[tag] = i
+		}
+	}
+	for i, column := range columns {
+		field, ok := fields[column]
+		if !ok {
+			return fmt.Errorf("no field in %s for column %q", dest.Type(), column)
+		}
+		if err := assign(dest.Field(field), values[i]); err != nil {
+			return fmt.Errorf("column %q: %w", column, err)
+		}
+	}
+	return nil
+}
+
+// assign stores an SQLite value in dest, converting between the driver's
+// int64, float64, string and []byte and the destination's kind.
+func assign(dest reflect.Value, value any) error {
+	if value == nil {
+		dest.SetZero()
+		return nil
+	}
+
+	switch dest.Kind() {
+	case reflect.String:
+		switch v := value.(type) {
+		case []byte:
+			dest.SetString(string(v))
+		default:
+			dest.SetString(fmt.Sprint(v))
+		}
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := value.(type) {
+		case int64:
+			dest.SetInt(v)
+			return nil
+		case float64:
+			dest.SetInt(int64(v))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch v := value.(type) {
+		case int64:
+			dest.SetFloat(float64(v))
+			return nil
+		case float64:
+			dest.SetFloat(v)
+			return nil
+		}
+	case reflect.Bool:
+		if v, ok := value.(int64); ok {
+			dest.SetBool(v != 0)
+			return nil
+		}
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(dest.Type()) {
+		dest.Set(v)
+		return nil
+	}
+	return fmt.Errorf("can't store %T in %s", value, dest.Type())
+}
@@ -0,0 +1,113 @@
+package connections
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	db, err := Open(Berries)
+	require.NoError(t, err)
+
+	again, err := Open(Berries)
+	require.NoError(t, err)
+	assert.Same(t, db, again, "each dataset is opened once")
+
+	_, err = Open(Dataset("missing.db"))
+	assert.ErrorContains(t, err, "unknown dataset")
+}
+
+func TestOpen_ReadOnly(t *testing.T) {
+	db, err := Open(Berries)
+	require.NoError(t, err)
+
+	_, err = db.Exec("DELETE FROM berries")
+	assert.Error(t, err)
+
+	count, err := QueryBerryData("SELECT COUNT(*) FROM berries")
+	require.NoError(t, err)
+	assert.NotEqual(t, "0", count[0])
+}
+
+func TestQueryBerries(t *testing.T) {
+	berries, err := QueryBerries("SELECT * FROM berries WHERE name = ?", "cheri")
+	require.NoError(t, err)
+	require.Len(t, berries, 1)
+
+	assert.Equal(t, structs.Berry{
+		ID:               1,
+		Name:             "cheri",
+		Effect:           "When paralyzed, cures paralysis",
+		Firmness:         "soft",
+		GrowthTime:       3,
+		MaxHarvest:       5,
+		NaturalGiftPower: 60,
+		NaturalGiftType:  "fire",
+		Size:             20,
+		Smoothness:       25,
+		SoilDryness:      15,
+		SpriteURL:        "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/items/cheri-berry.png",
+	}, berries[0])
+}
+
+func TestQueryBerryFlavors(t *testing.T) {
+	flavors, err := QueryBerryFlavors("SELECT * FROM berry_flavors WHERE berry_id = 1 AND potency > 0")
+	require.NoError(t, err)
+	assert.Equal(t, []structs.BerryFlavor{{BerryID: 1, FlavorName: "spicy", Potency: 10}}, flavors)
+}
+
+func TestQuery(t *testing.T) {
+	t.Run("single column types", func(t *testing.T) {
+		counts, err := Query[int](Berries, "SELECT COUNT(*) FROM berries")
+		require.NoError(t, err)
+		assert.Greater(t, counts[0], 0)
+
+		names, err := Query[string](Berries, "SELECT name FROM berries WHERE name = 'nonexistent'")
+		require.NoError(t, err)
+		assert.NotNil(t, names, "no rows is an empty slice")
+		assert.Empty(t, names)
+	})
+
+	t.Run("null columns", func(t *testing.T) {
+		berries, err := QueryBerries("SELECT NULL AS name, NULL AS growth_time")
+		require.NoError(t, err)
+		assert.Equal(t, structs.Berry{}, berries[0])
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		_, err := QueryBerries("SELECT name, 1 AS color FROM berries")
+		assert.ErrorContains(t, err, `column "color"`)
+	})
+
+	t.Run("too many columns", func(t *testing.T) {
+		_, err := Query[string](Berries, "SELECT name, firmness FROM berries")
+		assert.Error(t, err)
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		_, err := Query[int](Berries, "SELECT name FROM berries")
+		assert.Error(t, err)
+	})
+}
+
+func TestQuery_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := QueryBerries("SELECT * FROM berries")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
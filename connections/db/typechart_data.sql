@@ -0,0 +1,165 @@
+-- Type effectiveness by generation. Pairs without a row deal neutral (1x) damage.
+-- Build with: sqlite3 typechart.db < typechart_data.sql
+CREATE TABLE types (
+    name TEXT PRIMARY KEY,
+    introduced INTEGER NOT NULL,
+    tera_only INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE efficacy (
+    attacking TEXT NOT NULL,
+    defending TEXT NOT NULL,
+    from_gen INTEGER NOT NULL,
+    to_gen INTEGER NULL,
+    multiplier REAL NOT NULL,
+    FOREIGN KEY (attacking) REFERENCES types(name),
+    FOREIGN KEY (defending) REFERENCES types(name)
+);
+
+INSERT INTO types VALUES
+    ('normal', 1, 0),
+    ('fire', 1, 0),
+    ('water', 1, 0),
+    ('electric', 1, 0),
+    ('grass', 1, 0),
+    ('ice', 1, 0),
+    ('fighting', 1, 0),
+    ('poison', 1, 0),
+    ('ground', 1, 0),
+    ('flying', 1, 0),
+    ('psychic', 1, 0),
+    ('bug', 1, 0),
+    ('rock', 1, 0),
+    ('ghost', 1, 0),
+    ('dragon', 1, 0),
+    ('dark', 2, 0),
+    ('steel', 2, 0),
+    ('fairy', 6, 0),
+    ('stellar', 9, 1);
+
+INSERT INTO efficacy VALUES
+    ('normal', 'rock', 1, NULL, 0.5),
+    ('normal', 'ghost', 1, NULL, 0.0),
+    ('normal', 'steel', 1, NULL, 0.5),
+    ('fire', 'fire', 1, NULL, 0.5),
+    ('fire', 'water', 1, NULL, 0.5),
+    ('fire', 'grass', 1, NULL, 2.0),
+    ('fire', 'ice', 1, NULL, 2.0),
+    ('fire', 'bug', 1, NULL, 2.0),
+    ('fire', 'rock', 1, NULL, 0.5),
+    ('fire', 'dragon', 1, NULL, 0.5),
+    ('fire', 'steel', 1, NULL, 2.0),
+    ('water', 'fire', 1, NULL, 2.0),
+    ('water', 'water', 1, NULL, 0.5),
+    ('water', 'grass', 1, NULL, 0.5),
+    ('water', 'ground', 1, NULL, 2.0),
+    ('water', 'rock', 1, NULL, 2.0),
+    ('water', 'dragon', 1, NULL, 0.5),
+    ('electric', 'water', 1, NULL, 2.0),
+    ('electric', 'electric', 1, NULL, 0.5),
+    ('electric', 'grass', 1, NULL, 0.5),
+    ('electric', 'ground', 1, NULL, 0.0),
+    ('electric', 'flying', 1, NULL, 2.0),
+    ('electric', 'dragon', 1, NULL, 0.5),
+    ('grass', 'fire', 1, NULL, 0.5),
+    ('grass', 'water', 1, NULL, 2.0),
+    ('grass', 'grass', 1, NULL, 0.5),
+    ('grass', 'poison', 1, NULL, 0.5),
+    ('grass', 'ground', 1, NULL, 2.0),
+    ('grass', 'flying', 1, NULL, 0.5),
+    ('grass', 'bug', 1, NULL, 0.5),
+    ('grass', 'rock', 1, NULL, 2.0),
+    ('grass', 'dragon', 1, NULL, 0.5),
+    ('grass', 'steel', 1, NULL, 0.5),
+    ('ice', 'fire', 2, NULL, 0.5),
+    ('ice', 'water', 1, NULL, 0.5),
+    ('ice', 'grass', 1, NULL, 2.0),
+    ('ice', 'ice', 1, NULL, 0.5),
+    ('ice', 'ground', 1, NULL, 2.0),
+    ('ice', 'flying', 1, NULL, 2.0),
+    ('ice', 'dragon', 1, NULL, 2.0),
+    ('ice', 'steel', 1, NULL, 0.5),
+    ('fighting', 'normal', 1, NULL, 2.0),
+    ('fighting', 'ice', 1, NULL, 2.0),
+    ('fighting', 'poison', 1, NULL, 0.5),
+    ('fighting', 'flying', 1, NULL, 0.5),
+    ('fighting', 'psychic', 1, NULL, 0.5),
+    ('fighting', 'bug', 1, NULL, 0.5),
+    ('fighting', 'rock', 1, NULL, 2.0),
+    ('fighting', 'ghost', 1, NULL, 0.0),
+    ('fighting', 'dark', 1, NULL, 2.0),
+    ('fighting', 'steel', 1, NULL, 2.0),
+    ('fighting', 'fairy', 1, NULL, 0.5),
+    ('poison', 'grass', 1, NULL, 2.0),
+    ('poison', 'poison', 1, NULL, 0.5),
+    ('poison', 'ground', 1, NULL, 0.5),
+    ('poison', 'bug', 1, 1, 2.0),
+    ('poison', 'rock', 1, NULL, 0.5),
+    ('poison', 'ghost', 1, NULL, 0.5),
+    ('poison', 'steel', 1, NULL, 0.0),
+    ('poison', 'fairy', 1, NULL, 2.0),
+    ('ground', 'fire', 1, NULL, 2.0),
+    ('ground', 'electric', 1, NULL, 2.0),
+    ('ground', 'grass', 1, NULL, 0.5),
+    ('ground', 'poison', 1, NULL, 2.0),
+    ('ground', 'flying', 1, NULL, 0.0),
+    ('ground', 'bug', 1, NULL, 0.5),
+    ('ground', 'rock', 1, NULL, 2.0),
+    ('ground', 'steel', 1, NULL, 2.0),
+    ('flying', 'electric', 1, NULL, 0.5),
+    ('flying', 'grass', 1, NULL, 2.0),
+    ('flying', 'fighting', 1, NULL, 2.0),
+    ('flying', 'bug', 1, NULL, 2.0),
+    ('flying', 'rock', 1, NULL, 0.5),
+    ('flying', 'steel', 1, NULL, 0.5),
+    ('psychic', 'fighting', 1, NULL, 2.0),
+    ('psychic', 'poison', 1, NULL, 2.0),
+    ('psychic', 'psychic', 1, NULL, 0.5),
+    ('psychic', 'dark', 1, NULL, 0.0),
+    ('psychic', 'steel', 1, NULL, 0.5),
+    ('bug', 'fire', 1, NULL, 0.5),
+    ('bug', 'grass', 1, NULL, 2.0),
+    ('bug', 'fighting', 1, NULL, 0.5),
+    ('bug', 'poison', 1, 1, 2.0),
+    ('bug', 'poison', 2, NULL, 0.5),
+    ('bug', 'flying', 1, NULL, 0.5),
+    ('bug', 'psychic', 1, NULL, 2.0),
+    ('bug', 'ghost', 1, NULL, 0.5),
+    ('bug', 'dark', 1, NULL, 2.0),
+    ('bug', 'steel', 1, NULL, 0.5),
+    ('bug', 'fairy', 1, NULL, 0.5),
+    ('rock', 'fire', 1, NULL, 2.0),
+    ('rock', 'ice', 1, NULL, 2.0),
+    ('rock', 'fighting', 1, NULL, 0.5),
+    ('rock', 'ground', 1, NULL, 0.5),
+    ('rock', 'flying', 1, NULL, 2.0),
+    ('rock', 'bug', 1, NULL, 2.0),
+    ('rock', 'steel', 1, NULL, 0.5),
+    ('ghost', 'normal', 1, NULL, 0.0),
+    ('ghost', 'psychic', 1, 1, 0.0),
+    ('ghost', 'psychic', 2, NULL, 2.0),
+    ('ghost', 'ghost', 1, NULL, 2.0),
+    ('ghost', 'dark', 1, NULL, 0.5),
+    ('ghost', 'steel', 2, 5, 0.5),
+    ('dragon', 'dragon', 1, NULL, 2.0),
+    ('dragon', 'steel', 1, NULL, 0.5),
+    ('dragon', 'fairy', 1, NULL, 0.0),
+    ('dark', 'fighting', 1, NULL, 0.5),
+    ('dark', 'psychic', 1, NULL, 2.0),
+    ('dark', 'ghost', 1, NULL, 2.0),
+    ('dark', 'dark', 1, NULL, 0.5),
+    ('dark', 'steel', 2, 5, 0.5),
+    ('dark', 'fairy', 1, NULL, 0.5),
+    ('steel', 'fire', 1, NULL, 0.5),
+    ('steel', 'water', 1, NULL, 0.5),
+    ('steel', 'electric', 1, NULL, 0.5),
+    ('steel', 'ice', 1, NULL, 2.0),
+    ('steel', 'rock', 1, NULL, 2.0),
+    ('steel', 'steel', 1, NULL, 0.5),
+    ('steel', 'fairy', 1, NULL, 2.0),
+    ('fairy', 'fire', 1, NULL, 0.5),
+    ('fairy', 'fighting', 1, NULL, 2.0),
+    ('fairy', 'poison', 1, NULL, 0.5),
+    ('fairy', 'dragon', 1, NULL, 2.0),
+    ('fairy', 'dark', 1, NULL, 2.0),
+    ('fairy', 'steel', 1, NULL, 0.5);
@@ -6,3 +6,23 @@ const (
 	VersionSunMoon       = "sun-moon"
 	VersionXY            = "x-y"
 )
+
+// Generations are PokeAPI's generation names in order, so "generation-i" is Gen 1.
+var Generations = []string{
+	"generation-i", "generation-ii", "generation-iii", "generation-iv", "generation-v",
+	"generation-vi", "generation-vii", "generation-viii", "generation-ix",
+}
+
+// VersionGroupGenerations maps PokeAPI version groups to the generation of their games.
+var VersionGroupGenerations = map[string]int{
+	"red-blue": 1, "yellow": 1,
+	"gold-silver": 2, "crystal": 2,
+	"ruby-sapphire": 3, "emerald": 3, "firered-leafgreen": 3, "colosseum": 3, "xd": 3,
+	"diamond-pearl": 4, "platinum": 4, "heartgold-soulsilver": 4,
+	"black-white": 5, "black-2-white-2": 5,
+	VersionXY: 6, "omega-ruby-alpha-sapphire": 6,
+	VersionSunMoon: 7, "ultra-sun-ultra-moon": 7, "lets-go-pikachu-lets-go-eevee": 7,
+	VersionSwordShield: 8, "the-isle-of-armor": 8, "the-crown-tundra": 8,
+	"brilliant-diamond-and-shining-pearl": 8, "legends-arceus": 8,
+	VersionScarletViolet: 9, "the-teal-mask": 9, "the-indigo-disk": 9,
+}
@@ -11,7 +11,8 @@
                 "set_cardCount_official",
                 CONCAT(name, ' - ', LPAD("localId", 3, '0'), '/', LPAD("set_cardCount_official"::text, 3, '0')) AS card_combined_name,
                 set_name,
-                regulation_mark
+                regulation_mark,
+                attack_names
             FROM public.cards
         ),
          cards_pricing_cte AS (
@@ -32,7 +33,8 @@
             p."market_price",
             COALESCE(p."card_number", LPAD(c."localId", 3, '0')) AS card_number,
             c.illustrator,
-            c.regulation_mark
+            c.regulation_mark,
+            c.attack_names
         FROM
             cards_cte AS c
                 LEFT JOIN
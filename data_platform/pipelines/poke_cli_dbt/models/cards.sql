@@ -3,6 +3,7 @@
     post_hook="{{ enable_rls() }}"
 ) }}
 
-SELECT id, set_id, image, name, "localId", category, hp, "set_cardCount_official", set_name, illustrator, "regulationMark" AS regulation_mark
+SELECT id, set_id, image, name, "localId", category, hp, "set_cardCount_official", set_name, illustrator, "regulationMark" AS regulation_mark,
+       (SELECT STRING_AGG(attack ->> 'name', ', ') FROM JSONB_ARRAY_ELEMENTS(attacks_json::jsonb) AS attack) AS attack_names
 FROM {{ source('staging', 'cards') }}
 WHERE "localId" ~ '^[0-9]+$'
\ No newline at end of file
@@ -3,9 +3,15 @@ package flags
 import (
 	"fmt"
 	"io"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
 
+	"charm.land/lipgloss/v2"
 	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/constants"
+	"github.com/digitalghost-dev/poke-cli/structs"
 	"github.com/digitalghost-dev/poke-cli/styling"
 	flag "github.com/spf13/pflag"
 	"golang.org/x/text/cases"
@@ -13,20 +19,37 @@ import (
 )
 
 type AbilityFlags struct {
-	FlagSet *flag.FlagSet
-	Pokemon *bool
+	FlagSet    *flag.FlagSet
+	Gen        *int
+	HiddenOnly *bool
+	Pokemon    *bool
 }
 
+// abilityWorkers caps the concurrent API calls made for the Pokémon with an ability.
+const abilityWorkers = 8
+
+// Swapped out in tests.
+var (
+	abilityApiCall        = connections.AbilityApiCall
+	evolutionChainApiCall = connections.EvolutionChainApiCall
+	pokemonApiCall        = connections.PokemonApiCall
+	speciesApiCall        = connections.PokemonSpeciesApiCall
+)
+
 func SetupAbilityFlagSet() *AbilityFlags {
 	af := &AbilityFlags{}
 	af.FlagSet = flag.NewFlagSet("abilityFlags", flag.ContinueOnError)
 
 	af.Pokemon = af.FlagSet.BoolP("pokemon", "p", false, "List all Pokémon with chosen ability")
+	af.HiddenOnly = af.FlagSet.Bool("hidden-only", false, "With --pokemon, only list Pokémon with it as a hidden ability")
+	af.Gen = af.FlagSet.IntP("gen", "g", 0, "Show the ability as it was in a generation")
 
 	af.FlagSet.Usage = func() {
 		helpMessage := styling.HelpBorder.Render("poke-cli ability <ability-name> [flags]\n\n",
 			styling.StyleBold.Render("FLAGS:"),
 			fmt.Sprintf("\n\t%-30s %s", "-p, --pokemon", "List all Pokémon with chosen ability."),
+			fmt.Sprintf("\n\t%-30s %s", "--hidden-only", "With --pokemon, only list Pokémon with it as a hidden ability."),
+			fmt.Sprintf("\n\t%-30s %s", "-g, --gen", "Show the ability as it was in a generation."),
 		)
 		fmt.Println(helpMessage)
 	}
@@ -34,22 +57,253 @@ func SetupAbilityFlagSet() *AbilityFlags {
 	return af
 }
 
-func PokemonAbilitiesFlag(w io.Writer, endpoint string, abilityName string) error {
-	abilitiesStruct, _, err := connections.AbilityApiCall(endpoint, abilityName, connections.APIURL)
+// abilityHolder is a Pokémon with an ability and the details fetched for it.
+type abilityHolder struct {
+	name    string
+	hidden  bool
+	pokemon structs.PokemonJSONStruct
+	types   []string
+	gens    map[int]bool
+	err     error
+}
+
+// fetchAll calls fetch for each key with up to abilityWorkers calls at once.
+// Results and errors line up with keys.
+func fetchAll[T any](keys []string, fetch func(string) (T, error)) ([]T, []error) {
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, abilityWorkers)
+		results = make([]T, len(keys))
+		errs    = make([]error, len(keys))
+	)
+
+	for i, key := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, key string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = fetch(key)
+		}(i, key)
+	}
+
+	wg.Wait()
+	return results, errs
+}
+
+// fetchAbilityHolders looks up each Pokémon, its types and the generations
+// of the games it's in.
+func fetchAbilityHolders(holders []abilityHolder) {
+	names := make([]string, len(holders))
+	for i, h := range holders {
+		names[i] = h.name
+	}
+	pokemon, errs := fetchAll(names, func(name string) (structs.PokemonJSONStruct, error) {
+		p, _, err := pokemonApiCall("pokemon", name, connections.APIURL)
+		return p, err
+	})
+
+	for i := range holders {
+		h := &holders[i]
+		if h.err = errs[i]; h.err != nil {
+			continue
+		}
+		h.pokemon = pokemon[i]
+		for _, t := range h.pokemon.Types {
+			h.types = append(h.types, t.Type.Name)
+		}
+		h.gens = map[int]bool{}
+		for _, m := range h.pokemon.Moves {
+			for _, detail := range m.VersionGroupDetails {
+				h.gens[constants.VersionGroupGenerations[detail.VersionGroup.Name]] = true
+			}
+		}
+	}
+}
+
+// relatives are the Pokémon in the evolution families of the holders that
+// aren't holders themselves. The API only lists a Pokémon under the abilities
+// it has now, so this is where those that lost an ability, like Gengar and
+// Levitate, are found.
+func relatives(holders []abilityHolder) []abilityHolder {
+	known := map[string]bool{}
+	seenSpecies := map[string]bool{}
+	var species []string
+	for _, h := range holders {
+		known[h.name] = true
+		if name := h.pokemon.Species.Name; h.err == nil && name != "" && !seenSpecies[name] {
+			known[name] = true
+			seenSpecies[name] = true
+			species = append(species, name)
+		}
+	}
+
+	speciesStructs, errs := fetchAll(species, func(name string) (structs.PokemonSpeciesJSONStruct, error) {
+		s, _, err := speciesApiCall("pokemon-species", name, connections.APIURL)
+		return s, err
+	})
+	seenChains := map[string]bool{}
+	var chainIDs []string
+	for i, s := range speciesStructs {
+		id := resourceID(s.EvolutionChain.URL)
+		if errs[i] != nil || s.EvolutionChain.URL == "" || seenChains[id] {
+			continue
+		}
+		seenChains[id] = true
+		chainIDs = append(chainIDs, id)
+	}
+
+	chains, errs := fetchAll(chainIDs, func(id string) (structs.EvolutionChainJSONStruct, error) {
+		c, _, err := evolutionChainApiCall("evolution-chain", id, connections.APIURL)
+		return c, err
+	})
+	var found []abilityHolder
+	var walk func(link structs.EvolutionLink)
+	walk = func(link structs.EvolutionLink) {
+		if name := link.Species.Name; !known[name] {
+			known[name] = true
+			found = append(found, abilityHolder{name: name})
+		}
+		for _, next := range link.EvolvesTo {
+			walk(next)
+		}
+	}
+	for i, c := range chains {
+		if errs[i] == nil {
+			walk(c.Chain)
+		}
+	}
+	return found
+}
+
+// abilityInGen reports whether a Pokémon had an ability in a generation and
+// whether it was hidden. Each past_abilities entry holds the slots that
+// changed after its generation, so the earliest entry at or after gen wins.
+func abilityInGen(p structs.PokemonJSONStruct, abilityName string, gen int) (held, hidden bool) {
+	type slot struct {
+		name   string
+		hidden bool
+	}
+	slots := map[int]slot{}
+	for _, a := range p.Abilities {
+		slots[a.Slot] = slot{a.Ability.Name, a.Hidden}
+	}
+
+	past := p.PastAbilities
+	pastGen := func(i int) int { return slices.Index(constants.Generations, past[i].Generation.Name) + 1 }
+	order := make([]int, len(past))
+	for i := range order {
+		order[i] = i
+	}
+	// Newest first, so older entries overwrite newer ones
+	sort.SliceStable(order, func(i, j int) bool { return pastGen(order[i]) > pastGen(order[j]) })
+	for _, i := range order {
+		if pastGen(i) < gen {
+			continue
+		}
+		for _, a := range past[i].Abilities {
+			slots[a.Slot] = slot{a.Ability.Name, a.Hidden}
+		}
+	}
+
+	for _, s := range slots {
+		if s.name == abilityName {
+			return true, s.hidden
+		}
+	}
+	return false, false
+}
+
+// holdersInGen keeps the Pokémon that had the ability in a generation and
+// are in its games, with hidden set to how they had it then. Hidden
+// abilities don't exist before Gen 5. Pokémon that couldn't be loaded are
+// kept, since their games are unknown.
+func holdersInGen(holders []abilityHolder, abilityName string, gen int) []abilityHolder {
+	var inGen []abilityHolder
+	for _, h := range holders {
+		if h.err != nil {
+			inGen = append(inGen, h)
+			continue
+		}
+		held, hidden := abilityInGen(h.pokemon, abilityName, gen)
+		if !held || !h.gens[gen] || (hidden && gen < 5) {
+			continue
+		}
+		h.hidden = hidden
+		inGen = append(inGen, h)
+	}
+	return inGen
+}
+
+// PokemonAbilitiesFlag lists the Pokémon with an ability, marking hidden
+// abilities, and counts them by type. hiddenOnly keeps the Pokémon that have
+// it as a hidden ability, and a gen above 0 keeps those that had it in that
+// generation's games.
+func PokemonAbilitiesFlag(w io.Writer, endpoint string, abilityName string, hiddenOnly bool, gen int) error {
+	abilitiesStruct, _, err := abilityApiCall(endpoint, abilityName, connections.APIURL)
 	if err != nil {
 		return err
 	}
 
+	var holders []abilityHolder
+	for _, pokemon := range abilitiesStruct.Pokemon {
+		holders = append(holders, abilityHolder{name: pokemon.PokemonName.Name, hidden: pokemon.Hidden})
+	}
+	fetchAbilityHolders(holders)
+
+	if gen > 0 {
+		former := relatives(holders)
+		fetchAbilityHolders(former)
+		for _, h := range former {
+			if h.err == nil {
+				holders = append(holders, h)
+			}
+		}
+		// Pokémon that couldn't be loaded have no ID and go last
+		sort.SliceStable(holders, func(i, j int) bool {
+			a, b := holders[i].pokemon.ID, holders[j].pokemon.ID
+			return a != 0 && (b == 0 || a < b)
+		})
+		holders = holdersInGen(holders, abilitiesStruct.Name, gen)
+	}
+
+	if hiddenOnly {
+		var hidden []abilityHolder
+		for _, h := range holders {
+			if h.hidden {
+				hidden = append(hidden, h)
+			}
+		}
+		holders = hidden
+	}
+
 	capitalizedEffect := cases.Title(language.English).String(strings.ReplaceAll(abilityName, "-", " "))
+	title := "Pokemon with " + capitalizedEffect
+	if hiddenOnly {
+		title += " as a Hidden Ability"
+	}
+	if gen > 0 {
+		title += fmt.Sprintf(" in Gen %d", gen)
+	}
 
-	if _, err := fmt.Fprintf(w, "\n\n%s\n\n", styling.StyleUnderline.Render("Pokemon with "+capitalizedEffect)); err != nil {
+	if _, err := fmt.Fprintf(w, "\n\n%s\n\n", styling.StyleUnderline.Render(title)); err != nil {
+		return err
+	}
+	if len(holders) == 0 {
+		_, err := fmt.Fprintln(w, "None found.")
 		return err
 	}
 
 	// Extract Pokémon names and capitalize them
 	var pokemonNames []string
-	for _, pokemon := range abilitiesStruct.Pokemon {
-		pokemonNames = append(pokemonNames, cases.Title(language.English).String(pokemon.PokemonName.Name))
+	marked := false
+	for _, h := range holders {
+		name := cases.Title(language.English).String(h.name)
+		if h.hidden && !hiddenOnly {
+			name += " (H)"
+			marked = true
+		}
+		pokemonNames = append(pokemonNames, name)
 	}
 
 	// Print names in a grid format
@@ -72,6 +326,68 @@ func PokemonAbilitiesFlag(w io.Writer, endpoint string, abilityName string) erro
 			return err
 		}
 	}
+	if marked {
+		if _, err := fmt.Fprintln(w, styling.StyleItalic.Render("(H) marks a hidden ability.")); err != nil {
+			return err
+		}
+	}
+
+	return typeCounts(w, holders)
+}
+
+// typeCounts prints how many of the Pokémon have each type, most first. A
+// dual-type Pokémon counts toward both of its types.
+func typeCounts(w io.Writer, holders []abilityHolder) error {
+	counts := map[string]int{}
+	failed := 0
+	for _, h := range holders {
+		if h.err != nil {
+			failed++
+			continue
+		}
+		for _, t := range h.types {
+			counts[t]++
+		}
+	}
+
+	typeNames := make([]string, 0, len(counts))
+	for t := range counts {
+		typeNames = append(typeNames, t)
+	}
+	sort.Slice(typeNames, func(i, j int) bool {
+		if counts[typeNames[i]] != counts[typeNames[j]] {
+			return counts[typeNames[i]] > counts[typeNames[j]]
+		}
+		return typeNames[i] < typeNames[j]
+	})
+
+	if _, err := fmt.Fprintf(w, "\n%s\n", styling.StyleUnderline.Render("By Type")); err != nil {
+		return err
+	}
+
+	const cols = 4
+	for i, t := range typeNames {
+		color := lipgloss.NewStyle().Foreground(lipgloss.Color(styling.GetTypeColor(t)))
+		entry := fmt.Sprintf("%s %2d   ", color.Render(fmt.Sprintf("%-9s", styling.CapitalizeResourceName(t))), counts[t])
+		if _, err := fmt.Fprint(w, entry); err != nil {
+			return err
+		}
+		if (i+1)%cols == 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+	}
+	if len(typeNames)%cols != 0 {
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		if _, err := fmt.Fprintf(w, "%d Pokémon couldn't be loaded and aren't counted.\n", failed); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
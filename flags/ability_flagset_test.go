@@ -2,12 +2,15 @@ package flags
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/digitalghost-dev/poke-cli/structs"
 	"github.com/digitalghost-dev/poke-cli/styling"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +28,8 @@ func TestSetupAbilityFlagSet(t *testing.T) {
 		name     string
 	}{
 		{af.Pokemon, false, "Pokemon flag should be 'pokemon'"},
+		{af.HiddenOnly, false, "HiddenOnly flag should be 'hidden-only'"},
+		{af.Gen, 0, "Gen flag should be 'gen'"},
 	}
 
 	for _, tt := range flagTests {
@@ -35,7 +40,7 @@ func TestSetupAbilityFlagSet(t *testing.T) {
 
 func TestPokemonAbilitiesFlag_AbilityNotFound(t *testing.T) {
 	var buf bytes.Buffer
-	err := PokemonAbilitiesFlag(&buf, "ability", "notarealability")
+	err := PokemonAbilitiesFlag(&buf, "ability", "notarealability", false, 0)
 	require.Error(t, err)
 
 	actual := styling.StripANSI(err.Error())
@@ -49,7 +54,7 @@ func TestPokemonFlag(t *testing.T) {
 	r, w, _ := os.Pipe()
 	os.Stdout = w
 
-	err := PokemonAbilitiesFlag(&output, "ability", "stench")
+	err := PokemonAbilitiesFlag(&output, "ability", "stench", false, 0)
 
 	if closeErr := w.Close(); closeErr != nil {
 		t.Fatalf("Failed to close pipe writer: %v", closeErr)
@@ -69,8 +74,8 @@ func TestPokemonFlag(t *testing.T) {
 			"%2d. %-30s%2d. %-30s%2d. %-30s\n"+
 			"%2d. %-30s%2d. %-30s%2d. %-30s\n"+
 			"%2d. %-30s\n",
-		1, "Gloom", 2, "Grimer", 3, "Muk",
-		4, "Koffing", 5, "Weezing", 6, "Stunky",
+		1, "Gloom (H)", 2, "Grimer", 3, "Muk",
+		4, "Koffing (H)", 5, "Weezing (H)", 6, "Stunky",
 		7, "Skuntank", 8, "Trubbish", 9, "Garbodor",
 		10, "Garbodor-Gmax"),
 	)
@@ -83,3 +88,158 @@ func TestPokemonFlag(t *testing.T) {
 	}
 	assert.Contains(t, actualOutput, expectedOutput, "Output should contain Pokémon with the ability")
 }
+
+// stubAbilityAPI replaces the API calls with Levitate on three Pokémon.
+// Gastly is only in Gen 1 and 4 games, and Weezing fails to load. Gengar,
+// in Gastly's family, had Levitate until Gen 7.
+func stubAbilityAPI(t *testing.T) {
+	t.Helper()
+	origAbility, origPokemon := abilityApiCall, pokemonApiCall
+	origSpecies, origChain := speciesApiCall, evolutionChainApiCall
+	t.Cleanup(func() {
+		abilityApiCall, pokemonApiCall = origAbility, origPokemon
+		speciesApiCall, evolutionChainApiCall = origSpecies, origChain
+	})
+
+	abilityApiCall = func(endpoint, name, baseURL string) (structs.AbilityJSONStruct, string, error) {
+		var s structs.AbilityJSONStruct
+		err := json.Unmarshal([]byte(`{"name": "levitate", "pokemon": [
+			{"is_hidden": false, "pokemon": {"name": "gastly"}, "slot": 1},
+			{"is_hidden": true, "pokemon": {"name": "weezing"}, "slot": 3},
+			{"is_hidden": false, "pokemon": {"name": "bronzong"}, "slot": 1}
+		]}`), &s)
+		return s, name, err
+	}
+	pokemonApiCall = func(endpoint, name, baseURL string) (structs.PokemonJSONStruct, string, error) {
+		var s structs.PokemonJSONStruct
+		pokemon := map[string]string{
+			"gastly": `{"id": 92, "species": {"name": "gastly"},
+				"abilities": [{"ability": {"name": "levitate"}, "is_hidden": false, "slot": 1}],
+				"types": [{"type": {"name": "ghost"}}, {"type": {"name": "poison"}}],
+				"moves": [{"version_group_details": [{"version_group": {"name": "red-blue"}}, {"version_group": {"name": "diamond-pearl"}}]}]}`,
+			"gengar": `{"id": 94, "species": {"name": "gengar"},
+				"abilities": [{"ability": {"name": "cursed-body"}, "is_hidden": false, "slot": 1}],
+				"past_abilities": [{"abilities": [{"ability": {"name": "levitate"}, "is_hidden": false, "slot": 1}], "generation": {"name": "generation-vi"}}],
+				"types": [{"type": {"name": "ghost"}}, {"type": {"name": "poison"}}],
+				"moves": [{"version_group_details": [{"version_group": {"name": "diamond-pearl"}}, {"version_group": {"name": "scarlet-violet"}}]}]}`,
+			"bronzong": `{"id": 437, "species": {"name": "bronzong"},
+				"abilities": [{"ability": {"name": "levitate"}, "is_hidden": false, "slot": 1}],
+				"types": [{"type": {"name": "steel"}}, {"type": {"name": "psychic"}}],
+				"moves": [{"version_group_details": [{"version_group": {"name": "scarlet-violet"}}]}]}`,
+		}
+		body, ok := pokemon[name]
+		if !ok {
+			return s, name, errors.New("not found")
+		}
+		return s, name, json.Unmarshal([]byte(body), &s)
+	}
+	speciesApiCall = func(endpoint, name, baseURL string) (structs.PokemonSpeciesJSONStruct, string, error) {
+		var s structs.PokemonSpeciesJSONStruct
+		if name != "gastly" {
+			return s, name, errors.New("not found")
+		}
+		s.EvolutionChain.URL = "https://pokeapi.co/api/v2/evolution-chain/46/"
+		return s, name, nil
+	}
+	evolutionChainApiCall = func(endpoint, id, baseURL string) (structs.EvolutionChainJSONStruct, string, error) {
+		var s structs.EvolutionChainJSONStruct
+		if id != "46" {
+			return s, "", errors.New("not found")
+		}
+		err := json.Unmarshal([]byte(`{"id": 46, "chain": {"species": {"name": "gastly"}, "evolves_to": [
+			{"species": {"name": "haunter"}, "evolves_to": [{"species": {"name": "gengar"}, "evolves_to": []}]}
+		]}}`), &s)
+		return s, "gastly", err
+	}
+}
+
+func TestPokemonAbilitiesFlag_HiddenAndTypes(t *testing.T) {
+	stubAbilityAPI(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, PokemonAbilitiesFlag(&buf, "ability", "levitate", false, 0))
+	out := styling.StripANSI(buf.String())
+	assert.Contains(t, out, "Pokemon with Levitate")
+	assert.Contains(t, out, " 2. Weezing (H)")
+	assert.Contains(t, out, "(H) marks a hidden ability.")
+	assert.Contains(t, out, "By Type")
+	assert.Regexp(t, `Ghost\s+1`, out)
+	assert.Regexp(t, `Steel\s+1`, out)
+	assert.Contains(t, out, "1 Pokémon couldn't be loaded")
+	assert.NotContains(t, out, "Gengar", "Past holders are only looked up with --gen")
+
+	buf.Reset()
+	require.NoError(t, PokemonAbilitiesFlag(&buf, "ability", "levitate", true, 0))
+	out = styling.StripANSI(buf.String())
+	assert.Contains(t, out, "Pokemon with Levitate as a Hidden Ability")
+	assert.Contains(t, out, " 1. Weezing")
+	assert.NotContains(t, out, "(H)")
+	assert.NotContains(t, out, "Gastly")
+}
+
+func TestPokemonAbilitiesFlag_Gen(t *testing.T) {
+	stubAbilityAPI(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, PokemonAbilitiesFlag(&buf, "ability", "levitate", false, 9))
+	out := styling.StripANSI(buf.String())
+	assert.Contains(t, out, "Pokemon with Levitate in Gen 9")
+	assert.Contains(t, out, " 1. Bronzong")
+	assert.Contains(t, out, "Weezing (H)", "Pokémon that fail to load are kept")
+	assert.NotContains(t, out, "Gastly")
+	assert.NotContains(t, out, "Gengar", "Gengar lost Levitate in Gen 7")
+
+	buf.Reset()
+	require.NoError(t, PokemonAbilitiesFlag(&buf, "ability", "levitate", false, 4))
+	out = styling.StripANSI(buf.String())
+	assert.Contains(t, out, " 1. Gastly")
+	assert.Contains(t, out, " 2. Gengar", "Gengar had Levitate in Gen 4")
+	assert.Contains(t, out, " 3. Weezing")
+	assert.NotContains(t, out, "Bronzong")
+	assert.NotContains(t, out, "Haunter", "Haunter couldn't be loaded and isn't a current holder")
+
+	buf.Reset()
+	pokemonApiCall = func(endpoint, name, baseURL string) (structs.PokemonJSONStruct, string, error) {
+		return structs.PokemonJSONStruct{}, name, nil
+	}
+	require.NoError(t, PokemonAbilitiesFlag(&buf, "ability", "levitate", false, 3))
+	assert.Contains(t, styling.StripANSI(buf.String()), "None found.")
+}
+
+func TestPokemonAbilitiesFlag_GenHidden(t *testing.T) {
+	stubAbilityAPI(t)
+	pokemonApiCall = func(endpoint, name, baseURL string) (structs.PokemonJSONStruct, string, error) {
+		var s structs.PokemonJSONStruct
+		err := json.Unmarshal([]byte(`{"id": 110, "species": {"name": "weezing"},
+			"abilities": [{"ability": {"name": "levitate"}, "is_hidden": true, "slot": 3}],
+			"moves": [{"version_group_details": [{"version_group": {"name": "ruby-sapphire"}}, {"version_group": {"name": "black-white"}}]}]}`), &s)
+		return s, name, err
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, PokemonAbilitiesFlag(&buf, "ability", "levitate", false, 5))
+	assert.Contains(t, styling.StripANSI(buf.String()), "Weezing (H)")
+
+	buf.Reset()
+	require.NoError(t, PokemonAbilitiesFlag(&buf, "ability", "levitate", false, 3))
+	out := styling.StripANSI(buf.String())
+	assert.Contains(t, out, "None found.", "Hidden abilities didn't exist before Gen 5")
+}
+
+func TestAbilityInGen(t *testing.T) {
+	var gengar structs.PokemonJSONStruct
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"abilities": [{"ability": {"name": "cursed-body"}, "is_hidden": false, "slot": 1}],
+		"past_abilities": [{"abilities": [{"ability": {"name": "levitate"}, "is_hidden": false, "slot": 1}], "generation": {"name": "generation-vi"}}]
+	}`), &gengar))
+
+	for gen, want := range map[int]bool{3: true, 6: true, 7: false, 9: false} {
+		held, hidden := abilityInGen(gengar, "levitate", gen)
+		assert.Equal(t, want, held, "Gen %d", gen)
+		assert.False(t, hidden)
+	}
+	held, _ := abilityInGen(gengar, "cursed-body", 9)
+	assert.True(t, held)
+	held, _ = abilityInGen(gengar, "cursed-body", 4)
+	assert.False(t, held)
+}
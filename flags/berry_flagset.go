@@ -0,0 +1,83 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
+)
+
+type BerryFlags struct {
+	FlagSet    *flag.FlagSet
+	Flavor     *string
+	MinPotency *int
+	Firmness   *string
+	GiftType   *string
+	Sort       *string
+}
+
+func SetupBerryFlagSet() *BerryFlags {
+	bf := &BerryFlags{}
+	bf.FlagSet = flag.NewFlagSet("berryFlags", flag.ContinueOnError)
+
+	bf.Flavor = bf.FlagSet.StringP("flavor", "f", "", "Only berries with this flavor: spicy, dry, sweet, bitter or sour.")
+	bf.MinPotency = bf.FlagSet.IntP("min-potency", "p", 0, "Lowest potency of --flavor, or of the strongest flavor without it.")
+	bf.Firmness = bf.FlagSet.String("firmness", "", "Only berries with this firmness, e.g. soft or very-hard.")
+	bf.GiftType = bf.FlagSet.StringP("gift-type", "g", "", "Only berries whose Natural Gift has this type.")
+	bf.Sort = bf.FlagSet.StringP("sort", "s", "name", "Sort by name, growth_time, max_harvest, natural_gift_power, smoothness, size or potency.")
+
+	bf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli berry [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-f, --flavor", "Only berries with this flavor: spicy, dry, sweet, bitter or sour."),
+			fmt.Sprintf("\n\t%-30s %s", "-p, --min-potency", "Lowest potency of --flavor, or of the strongest flavor."),
+			fmt.Sprintf("\n\t%-30s %s", "--firmness", "Only berries with this firmness, e.g. soft or very-hard."),
+			fmt.Sprintf("\n\t%-30s %s", "-g, --gift-type", "Only berries whose Natural Gift has this type."),
+			fmt.Sprintf("\n\t%-30s %s", "-s, --sort", "Sort by name, growth_time, max_harvest, natural_gift_power,"),
+			fmt.Sprintf("\n\t%-30s %s", "", "smoothness, size or potency. Defaults to name."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return bf
+}
+
+type BerryPlanFlags struct {
+	FlagSet *flag.FlagSet
+	Flavor  *string
+	Target  *string
+	Berries *int
+	From    *[]string
+	Harvest *int
+	Berry   *string
+	Top     *int
+}
+
+func SetupBerryPlanFlagSet() *BerryPlanFlags {
+	pf := &BerryPlanFlags{}
+	pf.FlagSet = flag.NewFlagSet("berryPlanFlags", flag.ContinueOnError)
+
+	pf.Flavor = pf.FlagSet.StringP("flavor", "f", "", "Plan the strongest Poffin of this flavor.")
+	pf.Target = pf.FlagSet.StringP("target", "t", "", "Plan a Poffin close to these levels, e.g. spicy=30,dry=10.")
+	pf.Berries = pf.FlagSet.IntP("berries", "b", 4, "How many berries go into the Poffin, from 1 to 4.")
+	pf.From = pf.FlagSet.StringSlice("from", nil, "Only use these berries, e.g. cheri,oran,figy.")
+	pf.Harvest = pf.FlagSet.Int("harvest", 0, "Plan plants for at least this many berries.")
+	pf.Berry = pf.FlagSet.String("berry", "", "With --harvest, only plan this berry.")
+	pf.Top = pf.FlagSet.IntP("top", "n", 5, "How many plans to show.")
+
+	pf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli berry plan [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-f, --flavor", "Plan the strongest Poffin of this flavor."),
+			fmt.Sprintf("\n\t%-30s %s", "-t, --target", "Plan a Poffin close to these levels, e.g. spicy=30,dry=10."),
+			fmt.Sprintf("\n\t%-30s %s", "-b, --berries", "How many berries go into the Poffin, from 1 to 4."),
+			fmt.Sprintf("\n\t%-30s %s", "--from", "Only use these berries, e.g. cheri,oran,figy."),
+			fmt.Sprintf("\n\t%-30s %s", "--harvest", "Plan plants for at least this many berries."),
+			fmt.Sprintf("\n\t%-30s %s", "--berry", "With --harvest, only plan this berry."),
+			fmt.Sprintf("\n\t%-30s %s", "-n, --top", "How many plans to show."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return pf
+}
@@ -0,0 +1,82 @@
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupBerryFlagSet(t *testing.T) {
+	bf := SetupBerryFlagSet()
+
+	assert.NotNil(t, bf, "Flag set should not be nil")
+	assert.Equal(t, "berryFlags", bf.FlagSet.Name(), "Flag set name should be 'berryFlags'")
+
+	flagTests := []struct {
+		flag     interface{}
+		expected interface{}
+		name     string
+	}{
+		{bf.Flavor, "", "Flavor flag should default to empty"},
+		{bf.MinPotency, 0, "MinPotency flag should default to 0"},
+		{bf.Firmness, "", "Firmness flag should default to empty"},
+		{bf.GiftType, "", "GiftType flag should default to empty"},
+		{bf.Sort, "name", "Sort flag should default to name"},
+	}
+
+	for _, tt := range flagTests {
+		assert.NotNil(t, tt.flag, tt.name)
+		assert.Equal(t, tt.expected, reflect.ValueOf(tt.flag).Elem().Interface(), tt.name)
+	}
+}
+
+func TestBerryFlagSetParse(t *testing.T) {
+	bf := SetupBerryFlagSet()
+	err := bf.FlagSet.Parse([]string{"-f", "spicy", "-p", "20", "--firmness", "hard", "-g=fire", "--sort", "growth_time"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "spicy", *bf.Flavor)
+	assert.Equal(t, 20, *bf.MinPotency)
+	assert.Equal(t, "hard", *bf.Firmness)
+	assert.Equal(t, "fire", *bf.GiftType)
+	assert.Equal(t, "growth_time", *bf.Sort)
+}
+
+func TestSetupBerryPlanFlagSet(t *testing.T) {
+	pf := SetupBerryPlanFlagSet()
+
+	assert.NotNil(t, pf, "Flag set should not be nil")
+	assert.Equal(t, "berryPlanFlags", pf.FlagSet.Name(), "Flag set name should be 'berryPlanFlags'")
+
+	flagTests := []struct {
+		flag     interface{}
+		expected interface{}
+		name     string
+	}{
+		{pf.Flavor, "", "Flavor flag should default to empty"},
+		{pf.Target, "", "Target flag should default to empty"},
+		{pf.Berries, 4, "Berries flag should default to 4"},
+		{pf.From, []string(nil), "From flag should default to empty"},
+		{pf.Harvest, 0, "Harvest flag should default to 0"},
+		{pf.Berry, "", "Berry flag should default to empty"},
+		{pf.Top, 5, "Top flag should default to 5"},
+	}
+
+	for _, tt := range flagTests {
+		assert.NotNil(t, tt.flag, tt.name)
+		assert.Equal(t, tt.expected, reflect.ValueOf(tt.flag).Elem().Interface(), tt.name)
+	}
+}
+
+func TestBerryPlanFlagSetParse(t *testing.T) {
+	pf := SetupBerryPlanFlagSet()
+	err := pf.FlagSet.Parse([]string{"-t", "spicy=30,dry=10", "-b", "2", "--from", "cheri,oran", "--from", "figy", "-n", "3"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "spicy=30,dry=10", *pf.Target)
+	assert.Equal(t, 2, *pf.Berries)
+	assert.Equal(t, []string{"cheri", "oran", "figy"}, *pf.From)
+	assert.Equal(t, 3, *pf.Top)
+}
@@ -0,0 +1,60 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
+)
+
+type CardSearchFlags struct {
+	FlagSet     *flag.FlagSet
+	Attack      *bool
+	Illustrator *bool
+}
+
+func SetupCardSearchFlagSet() *CardSearchFlags {
+	cf := &CardSearchFlags{}
+	cf.FlagSet = flag.NewFlagSet("cardSearchFlags", flag.ContinueOnError)
+
+	cf.Attack = cf.FlagSet.BoolP("attack", "a", false, "Search cards by attack name")
+
+	cf.Illustrator = cf.FlagSet.BoolP("illustrator", "i", false, "Search cards by illustrator")
+
+	cf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli card search <query> [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-a, --attack", "Search cards by attack name."),
+			fmt.Sprintf("\n\t%-30s %s", "-i, --illustrator", "Search cards by illustrator."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return cf
+}
+
+type CardDownloadFlags struct {
+	FlagSet     *flag.FlagSet
+	Concurrency *int
+	Dir         *string
+}
+
+func SetupCardDownloadFlagSet() *CardDownloadFlags {
+	cf := &CardDownloadFlags{}
+	cf.FlagSet = flag.NewFlagSet("cardDownloadFlags", flag.ContinueOnError)
+
+	cf.Concurrency = cf.FlagSet.IntP("concurrency", "c", 4, "Number of images to download at once")
+
+	cf.Dir = cf.FlagSet.StringP("dir", "d", "", "Directory to save the images to")
+
+	cf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli card download <set-id> [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-c, --concurrency", "Number of images to download at once (1-16)."),
+			fmt.Sprintf("\n\t%-30s %s", "-d, --dir", "Directory to save the images to. Defaults to ./<set-id>."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return cf
+}
@@ -0,0 +1,73 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
+)
+
+type CompExportFlags struct {
+	FlagSet    *flag.FlagSet
+	Tournament *string
+	Format     *string
+	Table      *string
+}
+
+func SetupCompExportFlagSet() *CompExportFlags {
+	cf := &CompExportFlags{}
+	cf.FlagSet = flag.NewFlagSet("compExportFlags", flag.ContinueOnError)
+
+	cf.Tournament = cf.FlagSet.StringP("tournament", "t", "", "Tournament location to export")
+
+	cf.Format = cf.FlagSet.StringP("format", "f", "json", "Output format: json or csv")
+
+	cf.Table = cf.FlagSet.String("table", "", "Only export one table")
+
+	cf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli comp <tcg | vgc | champions> export [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-t, --tournament", "Tournament location to export. Defaults to the latest."),
+			fmt.Sprintf("\n\t%-30s %s", "-f, --format", "Output format: json or csv. Defaults to json."),
+			fmt.Sprintf("\n\t%-30s %s", "--table", "Only export one table. CSV defaults to the first."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return cf
+}
+
+type CompWatchFlags struct {
+	FlagSet  *flag.FlagSet
+	Comp     *[]string
+	Interval *time.Duration
+	Top      *int
+	Once     *bool
+}
+
+func SetupCompWatchFlagSet() *CompWatchFlags {
+	wf := &CompWatchFlags{}
+	wf.FlagSet = flag.NewFlagSet("compWatchFlags", flag.ContinueOnError)
+
+	wf.Comp = wf.FlagSet.StringSliceP("comp", "c", []string{"tcg", "vgc"}, "Competitions to watch")
+
+	wf.Interval = wf.FlagSet.DurationP("interval", "i", 10*time.Minute, "Time between polls")
+
+	wf.Top = wf.FlagSet.Int("top", 8, "Size of the top cut to watch")
+
+	wf.Once = wf.FlagSet.Bool("once", false, "Poll once and exit")
+
+	wf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli comp watch [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-c, --comp", "Competitions to watch: tcg, vgc or plugin IDs. Defaults to tcg,vgc."),
+			fmt.Sprintf("\n\t%-30s %s", "-i, --interval", "Time between polls, e.g. 5m or 1h. Defaults to 10m."),
+			fmt.Sprintf("\n\t%-30s %s", "--top", "Size of the top cut to watch. Defaults to 8."),
+			fmt.Sprintf("\n\t%-30s %s", "--once", "Poll once and exit, e.g. from cron."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return wf
+}
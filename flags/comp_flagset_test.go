@@ -0,0 +1,76 @@
+package flags
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupCompExportFlagSet(t *testing.T) {
+	cf := SetupCompExportFlagSet()
+
+	assert.NotNil(t, cf, "Flag set should not be nil")
+	assert.Equal(t, "compExportFlags", cf.FlagSet.Name(), "Flag set name should be 'compExportFlags'")
+
+	flagTests := []struct {
+		flag     interface{}
+		expected interface{}
+		name     string
+	}{
+		{cf.Tournament, "", "Tournament flag should default to empty"},
+		{cf.Format, "json", "Format flag should default to json"},
+		{cf.Table, "", "Table flag should default to empty"},
+	}
+
+	for _, tt := range flagTests {
+		assert.NotNil(t, tt.flag, tt.name)
+		assert.Equal(t, tt.expected, reflect.ValueOf(tt.flag).Elem().Interface(), tt.name)
+	}
+}
+
+func TestCompExportFlagSetParse(t *testing.T) {
+	cf := SetupCompExportFlagSet()
+	err := cf.FlagSet.Parse([]string{"-t", "Orlando, FL", "--format=csv", "--table", "decks"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Orlando, FL", *cf.Tournament)
+	assert.Equal(t, "csv", *cf.Format)
+	assert.Equal(t, "decks", *cf.Table)
+}
+
+func TestSetupCompWatchFlagSet(t *testing.T) {
+	wf := SetupCompWatchFlagSet()
+
+	assert.NotNil(t, wf, "Flag set should not be nil")
+	assert.Equal(t, "compWatchFlags", wf.FlagSet.Name(), "Flag set name should be 'compWatchFlags'")
+
+	flagTests := []struct {
+		flag     interface{}
+		expected interface{}
+		name     string
+	}{
+		{wf.Comp, []string{"tcg", "vgc"}, "Comp flag should default to tcg and vgc"},
+		{wf.Interval, 10 * time.Minute, "Interval flag should default to 10 minutes"},
+		{wf.Top, 8, "Top flag should default to 8"},
+		{wf.Once, false, "Once flag should default to false"},
+	}
+
+	for _, tt := range flagTests {
+		assert.NotNil(t, tt.flag, tt.name)
+		assert.Equal(t, tt.expected, reflect.ValueOf(tt.flag).Elem().Interface(), tt.name)
+	}
+}
+
+func TestCompWatchFlagSetParse(t *testing.T) {
+	wf := SetupCompWatchFlagSet()
+	err := wf.FlagSet.Parse([]string{"-c", "vgc", "--interval=1h", "--top", "16", "--once"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"vgc"}, *wf.Comp)
+	assert.Equal(t, time.Hour, *wf.Interval)
+	assert.Equal(t, 16, *wf.Top)
+	assert.True(t, *wf.Once)
+}
@@ -28,6 +28,7 @@ type Config struct {
 	Version int     `toml:"version"`
 	Display Display `toml:"display"`
 	Cache   Cache   `toml:"cache"`
+	Comp    Comp    `toml:"comp"`
 }
 
 type Display struct {
@@ -42,6 +43,13 @@ type Cache struct {
 	Path        string `toml:"path"`
 }
 
+// Comp configures 'comp watch'. NotifyCommand, when set, runs for every event
+// with the event's title and message appended as arguments and the event's
+// JSON on stdin, e.g. ["notify-send", "--app-name=poke-cli"].
+type Comp struct {
+	NotifyCommand []string `toml:"notify_command,omitempty"`
+}
+
 func Defaults() Config {
 	return Config{
 		Version: SchemaVersion,
@@ -64,6 +72,16 @@ func Path() (string, error) {
 	return filepath.Join(dir, "poke-cli", "config.toml"), nil
 }
 
+// StatePath resolves a state file stored next to the config, such as the
+// snapshot kept by 'comp watch'.
+func StatePath(name string) (string, error) {
+	path, err := Path()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), name), nil
+}
+
 // Load resolves the real path and delegates to LoadFrom.
 func Load() (Config, bool, error) {
 	path, err := Path()
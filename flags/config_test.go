@@ -95,3 +95,23 @@ func TestSaveToCleansUpTempOnFailure(t *testing.T) {
 		assert.False(t, strings.HasPrefix(e.Name(), "config-"), "temp file %q should have been cleaned up", e.Name())
 	}
 }
+
+func TestLoadFromNotifyCommand(t *testing.T) {
+	path := writeTempConfig(t, "[comp]\nnotify_command = [\"notify-send\", \"--app-name=poke-cli\"]\n")
+
+	cfg, _, err := LoadFrom(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"notify-send", "--app-name=poke-cli"}, cfg.Comp.NotifyCommand)
+	assert.Equal(t, ThemeYellow, cfg.Display.Theme)
+}
+
+func TestStatePathSitsNextToConfig(t *testing.T) {
+	configPath, err := Path()
+	require.NoError(t, err)
+
+	statePath, err := StatePath("comp-watch.json")
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(filepath.Dir(configPath), "comp-watch.json"), statePath)
+}
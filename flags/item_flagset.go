@@ -0,0 +1,243 @@
+// This file holds all the flags used by the <itemName> subcommand
+
+package flags
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/constants"
+	"github.com/digitalghost-dev/poke-cli/imaging"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
+)
+
+const maxItemSpriteBytes = 1024 * 1024 // 1 MiB
+
+// machineWorkers caps the concurrent API calls for the machines an item is.
+const machineWorkers = 8
+
+// Swapped out in tests.
+var (
+	itemApiCall    = connections.ItemApiCall
+	machineApiCall = connections.MachineApiCall
+)
+
+type ItemFlags struct {
+	FlagSet *flag.FlagSet
+	Image   *bool
+	Machine *bool
+	Pokemon *bool
+}
+
+func SetupItemFlagSet() *ItemFlags {
+	itf := &ItemFlags{}
+	itf.FlagSet = flag.NewFlagSet("itemFlags", flag.ContinueOnError)
+
+	itf.Image = itf.FlagSet.BoolP("image", "i", false, "Print the item's sprite")
+
+	itf.Machine = itf.FlagSet.BoolP("machine", "m", false, "Print the move the TM, HM or TR teaches in each game")
+
+	itf.Pokemon = itf.FlagSet.BoolP("pokemon", "p", false, "Print the wild Pokémon that hold the item in each game")
+
+	itf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli item <item-name> [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-i, --image", "Prints the item's sprite."),
+			fmt.Sprintf("\n\t%-30s %s", "-m, --machine", "Prints the move the TM, HM or TR teaches in each game."),
+			fmt.Sprintf("\n\t%-30s %s", "-p, --pokemon", "Prints the wild Pokémon that hold the item in each game."),
+			fmt.Sprintf("\n\t%-30s %s", "-h, --help", "Prints the help menu."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return itf
+}
+
+// resourceID is the ID at the end of a PokeAPI resource URL such as
+// "https://pokeapi.co/api/v2/version/33/". IDs follow release order.
+func resourceID(url string) string {
+	return path.Base(strings.TrimSuffix(url, "/"))
+}
+
+func resourceOrder(url string) int {
+	id, _ := strconv.Atoi(resourceID(url))
+	return id
+}
+
+func itemTable(headers []string, widths []int, rows [][]string) *table.Table {
+	isDark := styling.HasDarkBackground()
+	ld := lipgloss.LightDark(isDark)
+	color := ld(lipgloss.Color("#4B4B4B"), lipgloss.Color("#D3D3D3"))
+
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(color)).
+		StyleFunc(func(row, column int) lipgloss.Style {
+			return lipgloss.NewStyle().Width(widths[column])
+		}).
+		Headers(headers...).
+		Rows(rows...)
+}
+
+// HeldByFlag prints the wild Pokémon that hold an item in each game, with
+// the chance of finding it on them.
+func HeldByFlag(w io.Writer, endpoint string, itemName string) error {
+	itemStruct, _, err := itemApiCall(endpoint, itemName, connections.APIURL)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, header("Held by Wild Pokémon"))
+	if err != nil {
+		return err
+	}
+
+	type holder struct {
+		pokemon string
+		rarity  int
+	}
+	byVersion := map[string][]holder{}
+	order := map[string]int{}
+	for _, held := range itemStruct.HeldByPokemon {
+		for _, detail := range held.VersionDetails {
+			version := detail.Version.Name
+			byVersion[version] = append(byVersion[version], holder{held.Pokemon.Name, detail.Rarity})
+			order[version] = resourceOrder(detail.Version.URL)
+		}
+	}
+
+	if len(byVersion) == 0 {
+		_, err := fmt.Fprintf(w, "No wild Pokémon hold %s.\n", styling.CapitalizeResourceName(itemStruct.Name))
+		return err
+	}
+
+	versions := make([]string, 0, len(byVersion))
+	for v := range byVersion {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		if order[versions[i]] != order[versions[j]] {
+			return order[versions[i]] < order[versions[j]]
+		}
+		return versions[i] < versions[j]
+	})
+
+	var rows [][]string
+	for _, v := range versions {
+		holders := byVersion[v]
+		sort.SliceStable(holders, func(i, j int) bool { return holders[i].rarity > holders[j].rarity })
+
+		names := make([]string, len(holders))
+		for i, h := range holders {
+			names[i] = fmt.Sprintf("%s (%d%%)", styling.CapitalizeResourceName(h.pokemon), h.rarity)
+		}
+		rows = append(rows, []string{styling.CapitalizeResourceName(v), strings.Join(names, ", ")})
+	}
+
+	_, err = fmt.Fprintln(w, itemTable([]string{"Version", "Pokémon (chance held)"}, []int{20, 50}, rows))
+	return err
+}
+
+// MachineFlag prints the move a TM, HM or TR teaches in each version group.
+func MachineFlag(w io.Writer, endpoint string, itemName string) error {
+	itemStruct, _, err := itemApiCall(endpoint, itemName, connections.APIURL)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, header("Machine"))
+	if err != nil {
+		return err
+	}
+
+	if len(itemStruct.Machines) == 0 {
+		_, err := fmt.Fprintf(w, "%s isn't a TM, HM or TR.\n", styling.CapitalizeResourceName(itemStruct.Name))
+		return err
+	}
+
+	machines := itemStruct.Machines
+	sort.SliceStable(machines, func(i, j int) bool {
+		gi := constants.VersionGroupGenerations[machines[i].VersionGroup.Name]
+		gj := constants.VersionGroupGenerations[machines[j].VersionGroup.Name]
+		if gi != gj {
+			return gi < gj
+		}
+		return resourceOrder(machines[i].VersionGroup.URL) < resourceOrder(machines[j].VersionGroup.URL)
+	})
+
+	var (
+		wg    sync.WaitGroup
+		sem   = make(chan struct{}, machineWorkers)
+		moves = make([]string, len(machines))
+	)
+	for i, m := range machines {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, url string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			machineStruct, _, err := machineApiCall("machine", resourceID(url), connections.APIURL)
+			if err != nil {
+				moves[i] = "?"
+				return
+			}
+			moves[i] = styling.CapitalizeResourceName(machineStruct.Move.Name)
+		}(i, m.Machine.URL)
+	}
+	wg.Wait()
+
+	var rows [][]string
+	for i, m := range machines {
+		gen := "?"
+		if g := constants.VersionGroupGenerations[m.VersionGroup.Name]; g > 0 {
+			gen = strconv.Itoa(g)
+		}
+		rows = append(rows, []string{styling.CapitalizeResourceName(m.VersionGroup.Name), gen, moves[i]})
+	}
+
+	_, err = fmt.Fprintln(w, itemTable([]string{"Games", "Gen", "Move"}, []int{36, 5, 20}, rows))
+	return err
+}
+
+// ItemImageFlag prints the item's sprite.
+func ItemImageFlag(w io.Writer, endpoint string, itemName string) error {
+	itemStruct, _, err := itemApiCall(endpoint, itemName, connections.APIURL)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, header("Image"))
+	if err != nil {
+		return err
+	}
+
+	img, err := imaging.Fetch(itemStruct.Sprites.Default, maxItemSpriteBytes)
+	if err != nil {
+		return fmt.Errorf("error loading sprite image: %w", err)
+	}
+
+	// Item sprites are 30x30 pixel art, so graphics protocols scale them up.
+	protocol := imaging.Best()
+	opts := imaging.Options{Width: 30, Height: 30}
+	if protocol.Graphics() {
+		opts = imaging.Options{Width: 90, Height: 90}
+	}
+
+	imgStr, err := imaging.Render(img, protocol, opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(w, imgStr)
+	return err
+}
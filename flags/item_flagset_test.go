@@ -0,0 +1,134 @@
+package flags
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupItemFlagSet(t *testing.T) {
+	itf := SetupItemFlagSet()
+
+	assert.NotNil(t, itf, "Flag set should not be nil")
+	assert.Equal(t, "itemFlags", itf.FlagSet.Name(), "Flag set name should be 'itemFlags'")
+
+	flagTests := []struct {
+		flag     interface{}
+		expected interface{}
+		name     string
+	}{
+		{itf.Image, false, "Image flag should be 'image'"},
+		{itf.Machine, false, "Machine flag should be 'machine'"},
+		{itf.Pokemon, false, "Pokemon flag should be 'pokemon'"},
+	}
+
+	for _, tt := range flagTests {
+		assert.NotNil(t, tt.flag, tt.name)
+		assert.Equal(t, tt.expected, reflect.ValueOf(tt.flag).Elem().Interface(), tt.name)
+	}
+
+	require.NoError(t, itf.FlagSet.Parse([]string{"-i", "--machine", "-p"}))
+	assert.True(t, *itf.Image)
+	assert.True(t, *itf.Machine)
+	assert.True(t, *itf.Pokemon)
+}
+
+// stubItemAPI replaces the API calls with TM24, which Snorlax and Munchlax
+// hold in the wild. Machine 2 fails to load.
+func stubItemAPI(t *testing.T) {
+	t.Helper()
+	origItem, origMachine := itemApiCall, machineApiCall
+	t.Cleanup(func() {
+		itemApiCall, machineApiCall = origItem, origMachine
+	})
+
+	itemApiCall = func(endpoint, name, baseURL string) (structs.ItemJSONStruct, string, error) {
+		var s structs.ItemJSONStruct
+		if name != "tm24" {
+			err := json.Unmarshal([]byte(`{"name": "`+name+`"}`), &s)
+			return s, name, err
+		}
+		err := json.Unmarshal([]byte(`{
+			"name": "tm24",
+			"held_by_pokemon": [
+				{"pokemon": {"name": "munchlax"}, "version_details": [
+					{"rarity": 5, "version": {"name": "shield", "url": "https://pokeapi.co/api/v2/version/34/"}}
+				]},
+				{"pokemon": {"name": "snorlax"}, "version_details": [
+					{"rarity": 50, "version": {"name": "shield", "url": "https://pokeapi.co/api/v2/version/34/"}},
+					{"rarity": 100, "version": {"name": "red", "url": "https://pokeapi.co/api/v2/version/1/"}}
+				]}
+			],
+			"machines": [
+				{"machine": {"url": "https://pokeapi.co/api/v2/machine/3/"}, "version_group": {"name": "scarlet-violet", "url": "https://pokeapi.co/api/v2/version-group/25/"}},
+				{"machine": {"url": "https://pokeapi.co/api/v2/machine/1/"}, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}},
+				{"machine": {"url": "https://pokeapi.co/api/v2/machine/2/"}, "version_group": {"name": "yellow", "url": "https://pokeapi.co/api/v2/version-group/2/"}}
+			]
+		}`), &s)
+		return s, name, err
+	}
+	machineApiCall = func(endpoint, id, baseURL string) (structs.MachineJSONStruct, string, error) {
+		moves := map[string]string{"1": "thunderbolt", "3": "dragon-claw"}
+		move, ok := moves[id]
+		if !ok {
+			return structs.MachineJSONStruct{}, "", errors.New("not found")
+		}
+		var s structs.MachineJSONStruct
+		s.Move.Name = move
+		return s, "tm24", nil
+	}
+}
+
+func TestHeldByFlag(t *testing.T) {
+	stubItemAPI(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, HeldByFlag(&buf, "item", "tm24"))
+	out := styling.StripANSI(buf.String())
+	assert.Contains(t, out, "Held by Wild Pokémon")
+	assert.Contains(t, out, "Snorlax (50%), Munchlax (5%)")
+	assert.Less(t, strings.Index(out, "Red"), strings.Index(out, "Shield"), "Versions should be in release order")
+
+	buf.Reset()
+	require.NoError(t, HeldByFlag(&buf, "item", "choice-band"))
+	assert.Contains(t, styling.StripANSI(buf.String()), "No wild Pokémon hold Choice Band.")
+}
+
+func TestMachineFlag(t *testing.T) {
+	stubItemAPI(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, MachineFlag(&buf, "item", "tm24"))
+	out := styling.StripANSI(buf.String())
+	assert.Regexp(t, `Red Blue\s+│\s*1\s+│\s*Thunderbolt`, out)
+	assert.Regexp(t, `Yellow\s+│\s*1\s+│\s*\?`, out)
+	assert.Regexp(t, `Scarlet Violet\s+│\s*9\s+│\s*Dragon Claw`, out)
+	assert.Less(t, strings.Index(out, "Yellow"), strings.Index(out, "Scarlet Violet"))
+
+	buf.Reset()
+	require.NoError(t, MachineFlag(&buf, "item", "choice-band"))
+	assert.Contains(t, styling.StripANSI(buf.String()), "Choice Band isn't a TM, HM or TR.")
+}
+
+func TestItemImageFlag_NoSprite(t *testing.T) {
+	stubItemAPI(t)
+
+	var buf bytes.Buffer
+	err := ItemImageFlag(&buf, "item", "choice-band")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "image is not available from the API")
+}
+
+func TestResourceID(t *testing.T) {
+	assert.Equal(t, "1688", resourceID("https://pokeapi.co/api/v2/machine/1688/"))
+	assert.Equal(t, 34, resourceOrder("https://pokeapi.co/api/v2/version/34"))
+	assert.Equal(t, 0, resourceOrder(""))
+}
@@ -11,8 +11,19 @@ import (
 )
 
 type MechanicsFlags struct {
-	FlagSet *flag.FlagSet
-	Natures *bool
+	FlagSet  *flag.FlagSet
+	Natures  *bool
+	Stages   *bool
+	Accuracy *bool
+	Crit     *bool
+	Weather  *bool
+	Terrain  *bool
+	Status   *bool
+	Exp      *bool
+	Format   *string
+	For      *string
+	Boost    *string
+	Lower    *string
 }
 
 func SetupMechanicsFlagSet() *MechanicsFlags {
@@ -20,11 +31,33 @@ func SetupMechanicsFlagSet() *MechanicsFlags {
 	mf.FlagSet = flag.NewFlagSet("mechanicsFlags", flag.ContinueOnError)
 
 	mf.Natures = mf.FlagSet.BoolP("natures", "n", false, "Show a table with natures.")
+	mf.Stages = mf.FlagSet.BoolP("stages", "s", false, "Show the stat stage multipliers.")
+	mf.Accuracy = mf.FlagSet.BoolP("accuracy", "a", false, "Show the accuracy and evasion stage multipliers.")
+	mf.Crit = mf.FlagSet.BoolP("crit", "c", false, "Show the critical hit stages.")
+	mf.Weather = mf.FlagSet.BoolP("weather", "w", false, "Show the effects of weather.")
+	mf.Terrain = mf.FlagSet.BoolP("terrain", "t", false, "Show the effects of terrain.")
+	mf.Status = mf.FlagSet.BoolP("status", "S", false, "Show the effects of status conditions.")
+	mf.Exp = mf.FlagSet.BoolP("exp", "e", false, "Show the EXP needed per level for each growth rate.")
+	mf.Format = mf.FlagSet.StringP("format", "f", "table", "Output format: table, json or csv.")
+	mf.For = mf.FlagSet.String("for", "", "With --natures, suggest natures for a Pokémon.")
+	mf.Boost = mf.FlagSet.StringP("boost", "b", "", "With --natures, only natures that raise this stat.")
+	mf.Lower = mf.FlagSet.StringP("lower", "l", "", "With --natures, only natures that lower this stat.")
 
 	mf.FlagSet.Usage = func() {
 		helpMessage := styling.HelpBorder.Render("poke-cli mechanics [flags]\n\n",
 			styling.StyleBold.Render("FLAGS:"),
 			fmt.Sprintf("\n\t%-30s %s", "-n, --natures", "Show a table with natures."),
+			fmt.Sprintf("\n\t%-30s %s", "-s, --stages", "Show the stat stage multipliers."),
+			fmt.Sprintf("\n\t%-30s %s", "-a, --accuracy", "Show the accuracy and evasion stage multipliers."),
+			fmt.Sprintf("\n\t%-30s %s", "-c, --crit", "Show the critical hit stages."),
+			fmt.Sprintf("\n\t%-30s %s", "-w, --weather", "Show the effects of weather."),
+			fmt.Sprintf("\n\t%-30s %s", "-t, --terrain", "Show the effects of terrain."),
+			fmt.Sprintf("\n\t%-30s %s", "-S, --status", "Show the effects of status conditions."),
+			fmt.Sprintf("\n\t%-30s %s", "-e, --exp", "Show the EXP needed per level for each growth rate."),
+			fmt.Sprintf("\n\t%-30s %s", "-f, --format", "Output format: table, json or csv. Defaults to table."),
+			fmt.Sprintf("\n\t%-30s %s", "--for", "With --natures, suggest natures for a Pokémon."),
+			fmt.Sprintf("\n\t%-30s %s", "-b, --boost", "With --natures, only natures that raise this stat."),
+			fmt.Sprintf("\n\t%-30s %s", "-l, --lower", "With --natures, only natures that lower this stat."),
 		)
 		fmt.Println(helpMessage)
 	}
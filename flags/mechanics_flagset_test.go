@@ -21,6 +21,17 @@ func TestSetupMechanicsFlagSet(t *testing.T) {
 		name     string
 	}{
 		{mf.Natures, false, "Natures flag should default to false"},
+		{mf.Stages, false, "Stages flag should default to false"},
+		{mf.Accuracy, false, "Accuracy flag should default to false"},
+		{mf.Crit, false, "Crit flag should default to false"},
+		{mf.Weather, false, "Weather flag should default to false"},
+		{mf.Terrain, false, "Terrain flag should default to false"},
+		{mf.Status, false, "Status flag should default to false"},
+		{mf.Exp, false, "Exp flag should default to false"},
+		{mf.Format, "table", "Format flag should default to table"},
+		{mf.For, "", "For flag should default to empty"},
+		{mf.Boost, "", "Boost flag should default to empty"},
+		{mf.Lower, "", "Lower flag should default to empty"},
 	}
 
 	for _, tt := range flagTests {
@@ -50,6 +61,24 @@ func TestMechanicsFlagSetParse(t *testing.T) {
 	}
 }
 
+func TestMechanicsFlagSetParse_Reference(t *testing.T) {
+	mf := SetupMechanicsFlagSet()
+	require.NoError(t, mf.FlagSet.Parse([]string{"-s", "--crit", "-S", "-e", "--format", "json"}))
+
+	assert.True(t, *mf.Stages)
+	assert.True(t, *mf.Crit)
+	assert.True(t, *mf.Status)
+	assert.True(t, *mf.Exp)
+	assert.False(t, *mf.Weather)
+	assert.Equal(t, "json", *mf.Format)
+
+	mf = SetupMechanicsFlagSet()
+	require.NoError(t, mf.FlagSet.Parse([]string{"-n", "--for", "garchomp", "-b", "speed", "--lower=atk"}))
+	assert.Equal(t, "garchomp", *mf.For)
+	assert.Equal(t, "speed", *mf.Boost)
+	assert.Equal(t, "atk", *mf.Lower)
+}
+
 func TestNaturesFlag(t *testing.T) {
 	output := styling.StripANSI(NaturesFlag())
 
@@ -3,11 +3,11 @@
 package flags
 
 import (
+	"bytes"
 	"fmt"
 	"image"
 	"io"
 	"log"
-	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,12 +15,13 @@ import (
 
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/table"
+	"github.com/digitalghost-dev/poke-cli/audio"
 	cmdutils "github.com/digitalghost-dev/poke-cli/cmd/utils"
 	"github.com/digitalghost-dev/poke-cli/connections"
 	"github.com/digitalghost-dev/poke-cli/constants"
-	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/digitalghost-dev/poke-cli/imaging"
 	"github.com/digitalghost-dev/poke-cli/styling"
-	"github.com/disintegration/imaging"
+	"github.com/digitalghost-dev/poke-cli/typechart"
 	flag "github.com/spf13/pflag"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -28,14 +29,15 @@ import (
 
 const maxPokemonSpriteBytes = 5 * 1024 * 1024 // 5 MiB
 
-var pokemonSpriteHTTPClient = connections.NewDefaultHTTPClient()
-
 type PokemonFlags struct {
 	FlagSet   *flag.FlagSet
 	Abilities *bool
+	Cry       *string
 	Defenses  *bool
 	Image     *string
 	Moves     *bool
+	Save      *string
+	Sprite    *string
 	Stats     *bool
 }
 
@@ -60,24 +62,38 @@ func SetupPokemonFlagSet() *PokemonFlags {
 
 	pf.Abilities = pf.FlagSet.BoolP("abilities", "a", false, "Print the Pokémon's abilities")
 
+	pf.Cry = pf.FlagSet.String("cry", "", "Play the Pokémon's cry")
+	pf.FlagSet.Lookup("cry").NoOptDefVal = "latest"
+
 	pf.Defenses = pf.FlagSet.BoolP("defenses", "d", false, "Print the Pokémon's type defenses")
 
 	pf.Image = pf.FlagSet.StringP("image", "i", "", "Print the Pokémon's default sprite")
 
 	pf.Moves = pf.FlagSet.BoolP("moves", "m", false, "Print the Pokémon's learnable moves")
 
+	pf.Save = pf.FlagSet.String("save", "", "Save the Pokémon's sprite to a directory")
+
+	pf.Sprite = pf.FlagSet.String("sprite", "", "Choose which sprite --image shows")
+
 	pf.Stats = pf.FlagSet.BoolP("stats", "s", false, "Print the Pokémon's base stats")
 
 	hintMessage := styling.StyleItalic.Render("options: [sm, md, lg]")
+	cryHintMessage := styling.StyleItalic.Render("options: [latest, legacy]")
+	spriteHintMessage := styling.StyleItalic.Render("options: [shiny, female, back, artwork, home, animated]")
 
 	pf.FlagSet.Usage = func() {
 		helpMessage := styling.HelpBorder.Render("poke-cli pokemon <pokemon-name> [flags]\n\n",
 			styling.StyleBold.Render("FLAGS:"),
 			fmt.Sprintf("\n\t%-30s %s", "-a, --abilities", "Prints the Pokémon's abilities."),
+			fmt.Sprintf("\n\t%-30s %s", "--cry[=xx]", "Plays the Pokémon's cry, or saves it with --save."),
+			fmt.Sprintf("\n\t%5s%-15s", "", cryHintMessage),
 			fmt.Sprintf("\n\t%-30s %s", "-d, --defenses", "Prints the Pokémon's type defenses."),
 			fmt.Sprintf("\n\t%-30s %s", "-i=xx, --image=xx", "Prints out the Pokémon's default sprite."),
 			fmt.Sprintf("\n\t%5s%-15s", "", hintMessage),
 			fmt.Sprintf("\n\t%-30s %s", "-m, --moves", "Prints the Pokémon's learnable moves."),
+			fmt.Sprintf("\n\t%-30s %s", "--save=dir", "With --image or --cry, saves the file to a directory."),
+			fmt.Sprintf("\n\t%-30s %s", "--sprite=xx", "With --image, picks the sprite variant."),
+			fmt.Sprintf("\n\t%5s%-15s", "", spriteHintMessage),
 			fmt.Sprintf("\n\t%-30s %s", "-s, --stats", "Prints the Pokémon's base stats."),
 			fmt.Sprintf("\n\t%-30s %s", "-h, --help", "Prints the help menu."),
 		)
@@ -131,81 +147,23 @@ func DefenseFlag(w io.Writer, endpoint string, pokemonName string) error {
 		return err
 	}
 
-	allTypes := []string{"normal", "fire", "water", "electric", "grass", "ice",
-		"fighting", "poison", "ground", "flying", "psychic",
-		"bug", "rock", "ghost", "dragon", "dark", "steel", "fairy"}
-
-	typeData := make(map[string]structs.TypesJSONStruct)
-	for _, pokeType := range pokemonStruct.Types {
-		typeStruct, _, err := connections.TypesApiCall("type", pokeType.Type.Name, connections.APIURL)
-		if err != nil {
-			return err
-		}
-		typeData[pokeType.Type.Name] = typeStruct
+	chart, err := typechart.Latest()
+	if err != nil {
+		return err
 	}
 
-	calculateTypeEffectiveness := func(attackingType string) float64 {
-		totalEffectiveness := 1.0
-
-		for _, pokeType := range pokemonStruct.Types {
-			typeStruct := typeData[pokeType.Type.Name]
-			effectiveness := 1.0
-
-			// Check for double damage (weakness)
-			for _, dmgType := range typeStruct.DamageRelations.DoubleDamageFrom {
-				if dmgType.Name == attackingType {
-					effectiveness = 2.0
-					break
-				}
-			}
-
-			// Check for half damage (resistance)
-			for _, dmgType := range typeStruct.DamageRelations.HalfDamageFrom {
-				if dmgType.Name == attackingType {
-					effectiveness = 0.5
-					break
-				}
-			}
-
-			// Check for no damage (immunity)
-			for _, dmgType := range typeStruct.DamageRelations.NoDamageFrom {
-				if dmgType.Name == attackingType {
-					effectiveness = 0.0
-					break
-				}
-			}
-
-			totalEffectiveness *= effectiveness
-		}
-
-		return totalEffectiveness
+	var pokemonTypes []string
+	for _, pokeType := range pokemonStruct.Types {
+		pokemonTypes = append(pokemonTypes, pokeType.Type.Name)
 	}
 
 	// Check for abilities that grant immunities or resistances
 	checkAbilityEffects := func() error {
-		abilityImmunities := map[string][]string{
-			"flash-fire":    {"fire"},
-			"water-absorb":  {"water"},
-			"storm-drain":   {"water"},
-			"volt-absorb":   {"electric"},
-			"motor-drive":   {"electric"},
-			"lightning-rod": {"electric"},
-			"sap-sipper":    {"grass"},
-			"dry-skin":      {"water"},
-			"levitate":      {"ground"},
-			"earth-eater":   {"ground"},
-		}
-
-		abilityResistances := map[string][]string{
-			"thick-fat": {"fire", "ice"},
-			"heatproof": {"fire"},
-		}
-
 		for _, ability := range pokemonStruct.Abilities {
 			abilityName := ability.Ability.Name
 			formattedAbilityName := styling.CapitalizeResourceName(abilityName)
 
-			if types, exists := abilityImmunities[abilityName]; exists {
+			if types, exists := typechart.AbilityImmunities[abilityName]; exists {
 				typeList := strings.Join(types, " and ")
 				_, err := fmt.Fprintf(w, "%s, with the %s ability, grants it immunity to %s type moves.\n",
 					cases.Title(language.English).String(pokemonName), formattedAbilityName, typeList)
@@ -214,7 +172,7 @@ func DefenseFlag(w io.Writer, endpoint string, pokemonName string) error {
 				}
 			}
 
-			if types, exists := abilityResistances[abilityName]; exists {
+			if types, exists := typechart.AbilityResistances[abilityName]; exists {
 				typeList := strings.Join(types, " and ")
 				_, err := fmt.Fprintf(w, "%s, with the %s ability, grants it resistance to %s type moves.\n",
 					cases.Title(language.English).String(pokemonName), formattedAbilityName, typeList)
@@ -228,8 +186,8 @@ func DefenseFlag(w io.Writer, endpoint string, pokemonName string) error {
 
 	// Calculate effectiveness for all types
 	typeEffectiveness := make(map[string]float64)
-	for _, attackingType := range allTypes {
-		typeEffectiveness[attackingType] = calculateTypeEffectiveness(attackingType)
+	for _, attackingType := range chart.Types() {
+		typeEffectiveness[attackingType] = chart.Effectiveness(attackingType, pokemonTypes...)
 	}
 
 	var (
@@ -309,6 +267,12 @@ func DefenseFlag(w io.Writer, endpoint string, pokemonName string) error {
 }
 
 func ImageFlag(w io.Writer, endpoint string, pokemonName string, size string) error {
+	return SpriteFlag(w, endpoint, pokemonName, size, SpriteVariant{Style: SpriteStyleDefault})
+}
+
+// SpriteFlag prints one of the Pokémon's sprites. Animated sprites play in terminals
+// that support the kitty graphics protocol and show their first frame elsewhere.
+func SpriteFlag(w io.Writer, endpoint string, pokemonName string, size string, variant SpriteVariant) error {
 	sizeMap := map[string][2]int{
 		"lg": {120, 120},
 		"md": {90, 90},
@@ -325,78 +289,157 @@ func ImageFlag(w io.Writer, endpoint string, pokemonName string, size string) er
 		return err
 	}
 
+	spriteURL, err := variant.URL(pokemonStruct.Sprites)
+	if err != nil {
+		return err
+	}
+
 	// Print the header from header func
-	_, err = fmt.Fprintln(w, header("Image"))
+	title := "Image"
+	if name := variant.String(); name != "" {
+		title += " (" + name + ")"
+	}
+	_, err = fmt.Fprintln(w, header(title))
 	if err != nil {
 		return err
 	}
 
-	// Anonymous function to transform the image to a string
-	ToString := func(width int, height int, img image.Image) string {
-		img = imaging.Resize(img, width, height, imaging.NearestNeighbor)
-		b := img.Bounds()
+	data, err := imaging.Download(spriteURL, maxPokemonSpriteBytes)
+	if err != nil {
+		return fmt.Errorf("error downloading sprite image: %w", err)
+	}
 
-		imageWidth := b.Max.X
-		h := b.Max.Y
+	// Graphics protocols draw real pixels, so scale up to keep the sprite a similar size on screen.
+	// Artwork is painted rather than pixel art, so it's resized smoothly.
+	protocol := imaging.Best()
+	smooth := variant.Style == SpriteStyleArtwork || variant.Style == SpriteStyleHome
+	opts := imaging.Options{Width: dimensions[0], Height: dimensions[1], Smooth: smooth}
+	if protocol.Graphics() {
+		opts = imaging.Options{Width: dimensions[0] * 3, Height: dimensions[1] * 3, Smooth: smooth}
+	}
 
-		rowCount := (h - 1) / 2
-		if h%2 != 0 {
-			rowCount++
+	var imgStr string
+	if variant.Style == SpriteStyleAnimated {
+		anim, err := imaging.DecodeAnimation(data)
+		if err != nil {
+			return fmt.Errorf("error decoding image: %w", err)
 		}
-		estimatedSize := (imageWidth * rowCount * 55) + rowCount
-
-		str := strings.Builder{}
-		str.Grow(estimatedSize)
-
-		// Cache for lipgloss styles to avoid recreating identical styles
-		styleCache := make(map[string]lipgloss.Style)
-
-		for heightCounter := 0; heightCounter < h-1; heightCounter += 2 {
-			for x := 0; x < imageWidth; x++ {
-				// Get the color of the current and next row's pixels
-				c1, _ := styling.MakeColor(img.At(x, heightCounter))
-				color1 := lipgloss.Color(c1.Hex())
-				c2, _ := styling.MakeColor(img.At(x, heightCounter+1))
-				color2 := lipgloss.Color(c2.Hex())
-
-				styleKey := c1.Hex() + "_" + c2.Hex()
-				style, exists := styleCache[styleKey]
-				if !exists {
-					style = lipgloss.NewStyle().Foreground(color1).Background(color2)
-					styleCache[styleKey] = style
-				}
+		imgStr, err = imaging.RenderAnimation(anim, protocol, opts)
+		if err != nil {
+			return err
+		}
+	} else {
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("error decoding image: %w", err)
+		}
+		imgStr, err = imaging.Render(img, protocol, opts)
+		if err != nil {
+			return err
+		}
+	}
 
-				str.WriteString(style.Render("▀"))
-			}
+	_, err = fmt.Fprint(w, imgStr)
+	if err != nil {
+		return err
+	}
 
-			str.WriteString("\n")
-		}
+	return nil
+}
+
+// SaveImageFlag writes the Pokémon's sprite to dir, named after the Pokémon and the variant
+func SaveImageFlag(w io.Writer, endpoint string, pokemonName string, dir string, variant SpriteVariant) error {
+	pokemonStruct, _, err := connections.PokemonApiCall(endpoint, pokemonName, connections.APIURL)
+	if err != nil {
+		return err
+	}
 
-		return str.String()
+	spriteURL, err := variant.URL(pokemonStruct.Sprites)
+	if err != nil {
+		return err
 	}
 
-	imageResp, err := pokemonSpriteHTTPClient.Get(pokemonStruct.Sprites.FrontDefault)
+	data, err := imaging.Download(spriteURL, maxPokemonSpriteBytes)
 	if err != nil {
 		return fmt.Errorf("error downloading sprite image: %w", err)
 	}
-	defer imageResp.Body.Close()
 
-	if imageResp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected sprite response status: %d", imageResp.StatusCode)
+	name := strings.Join(append([]string{pokemonStruct.Name}, strings.Fields(variant.String())...), "-")
+	path, err := imaging.Save(dir, name, data)
+	if err != nil {
+		return fmt.Errorf("error saving sprite image: %w", err)
+	}
+
+	_, err = fmt.Fprintf(w, "\nSaved sprite to %s\n", path)
+	return err
+}
+
+// CryFlag plays the Pokémon's cry with an installed audio player. When dir is set, or no
+// player is installed, the cry is saved as <pokemon-name>-cry-<version>.ogg instead.
+func CryFlag(w io.Writer, endpoint string, pokemonName string, version string, dir string) error {
+	version = strings.ToLower(version)
+	if version != "latest" && version != "legacy" {
+		return fmt.Errorf("%s", cmdutils.FormatError("Invalid cry version.\nValid versions are: latest, legacy"))
+	}
+
+	pokemonStruct, _, err := connections.PokemonApiCall(endpoint, pokemonName, connections.APIURL)
+	if err != nil {
+		return err
+	}
+
+	cryURL := pokemonStruct.Cries.Latest
+	if version == "legacy" {
+		cryURL = pokemonStruct.Cries.Legacy
+	}
+	if cryURL == "" {
+		return fmt.Errorf("%s", cmdutils.FormatError(fmt.Sprintf("This Pokémon has no %s cry.", version)))
 	}
 
-	img, err := imaging.Decode(io.LimitReader(imageResp.Body, maxPokemonSpriteBytes))
+	data, err := audio.Download(cryURL)
 	if err != nil {
-		return fmt.Errorf("error decoding image: %w", err)
+		return fmt.Errorf("error downloading cry: %w", err)
 	}
 
-	imgStr := ToString(dimensions[0], dimensions[1], img)
-	_, err = fmt.Fprint(w, imgStr)
+	info, err := audio.Probe(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error reading cry: %w", err)
+	}
+	// Decoding catches a damaged cry before it reaches the player or the disk
+	if info.Codec == "Vorbis" {
+		if _, err := audio.Decode(bytes.NewReader(data)); err != nil {
+			return fmt.Errorf("error decoding cry: %w", err)
+		}
+	}
+
+	_, err = fmt.Fprintln(w, header("Cry"))
 	if err != nil {
 		return err
 	}
+	fmt.Fprintf(w, "Version: %s\nFormat: %s\n", version, info)
 
-	return nil
+	fileName := pokemonStruct.Name + "-cry-" + version
+
+	if dir == "" {
+		player, ok := audio.FindPlayer()
+		if ok {
+			if err := player.Play(data); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(w, "Played with %s\n", player.Name)
+			return err
+		}
+
+		fmt.Fprintf(w, "No audio player found (looked for %s).\n", strings.Join(audio.PlayerNames(), ", "))
+		dir = "."
+	}
+
+	path, err := audio.Save(dir, fileName, data)
+	if err != nil {
+		return fmt.Errorf("error saving cry: %w", err)
+	}
+
+	_, err = fmt.Fprintf(w, "Saved cry to %s\n", path)
+	return err
 }
 
 func MovesFlag(w io.Writer, endpoint string, pokemonName string) error {
@@ -24,9 +24,12 @@ func TestSetupPokemonFlagSet(t *testing.T) {
 		name     string
 	}{
 		{pf.Abilities, false, "Abilities flag should be 'abilities'"},
+		{pf.Cry, "", "Cry flag default value should be empty"},
 		{pf.Defenses, false, "Defenses flag should be 'defense'"},
 		{pf.Image, "", "Image flag default value should be 'md'"},
 		{pf.Moves, false, "Moves flag default value should be 'moves'"},
+		{pf.Save, "", "Save flag default value should be empty"},
+		{pf.Sprite, "", "Sprite flag default value should be empty"},
 		{pf.Stats, false, "Stats flag should be 'stats'"},
 	}
 
@@ -36,6 +39,30 @@ func TestSetupPokemonFlagSet(t *testing.T) {
 	}
 }
 
+func TestCryFlagParse(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--cry"}, "latest"},
+		{[]string{"--cry=legacy"}, "legacy"},
+		{[]string{}, ""},
+	}
+
+	for _, tt := range tests {
+		pf := SetupPokemonFlagSet()
+		require.NoError(t, pf.FlagSet.Parse(tt.args))
+		assert.Equal(t, tt.want, *pf.Cry, "args: %v", tt.args)
+	}
+}
+
+func TestCryFlag_InvalidVersion(t *testing.T) {
+	var output bytes.Buffer
+	err := CryFlag(&output, "pokemon", "pikachu", "newest", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid cry version")
+}
+
 func TestAbilitiesFlag(t *testing.T) {
 	var output bytes.Buffer
 	stdout := os.Stdout
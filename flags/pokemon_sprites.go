@@ -0,0 +1,119 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	cmdutils "github.com/digitalghost-dev/poke-cli/cmd/utils"
+	"github.com/digitalghost-dev/poke-cli/structs"
+)
+
+const (
+	SpriteStyleDefault  = "default"
+	SpriteStyleArtwork  = "artwork"
+	SpriteStyleHome     = "home"
+	SpriteStyleAnimated = "animated"
+)
+
+// SpriteVariant selects which of a Pokémon's sprites to show
+type SpriteVariant struct {
+	Style  string
+	Shiny  bool
+	Female bool
+	Back   bool
+}
+
+// ParseSpriteVariant reads a comma-separated --sprite value such as "shiny,back" or "artwork"
+func ParseSpriteVariant(value string) (SpriteVariant, error) {
+	variant := SpriteVariant{Style: SpriteStyleDefault}
+	if strings.TrimSpace(value) == "" {
+		return variant, nil
+	}
+
+	styleSet := false
+	for _, option := range strings.Split(strings.ToLower(value), ",") {
+		option = strings.TrimSpace(option)
+		switch option {
+		case "shiny":
+			variant.Shiny = true
+		case "female":
+			variant.Female = true
+		case "back":
+			variant.Back = true
+		case "official-artwork":
+			option = SpriteStyleArtwork
+			fallthrough
+		case SpriteStyleDefault, SpriteStyleArtwork, SpriteStyleHome, SpriteStyleAnimated:
+			if styleSet && variant.Style != option {
+				return variant, fmt.Errorf("%s", cmdutils.FormatError("Only one of default, artwork, home or animated can be used in --sprite."))
+			}
+			variant.Style = option
+			styleSet = true
+		default:
+			return variant, fmt.Errorf("%s", cmdutils.FormatError(fmt.Sprintf("Invalid sprite option '%s'.\nValid options are: shiny, female, back, artwork, home, animated", option)))
+		}
+	}
+
+	return variant, nil
+}
+
+// String describes the variant, e.g. "shiny back animated"
+func (v SpriteVariant) String() string {
+	var parts []string
+	if v.Shiny {
+		parts = append(parts, "shiny")
+	}
+	if v.Female {
+		parts = append(parts, "female")
+	}
+	if v.Back {
+		parts = append(parts, "back")
+	}
+	if v.Style != "" && v.Style != SpriteStyleDefault {
+		parts = append(parts, v.Style)
+	}
+	return strings.Join(parts, " ")
+}
+
+// URL picks the variant's sprite from the Pokémon's sprites
+func (v SpriteVariant) URL(sprites structs.PokemonSprites) (string, error) {
+	set := sprites.SpriteSet
+	switch v.Style {
+	case SpriteStyleArtwork:
+		set = sprites.Other.OfficialArtwork
+	case SpriteStyleHome:
+		set = sprites.Other.Home
+	case SpriteStyleAnimated:
+		set = sprites.Versions.GenerationV.BlackWhite.Animated
+	}
+
+	var url string
+	switch {
+	case v.Back && v.Shiny && v.Female:
+		url = set.BackShinyFemale
+	case v.Back && v.Shiny:
+		url = set.BackShiny
+	case v.Back && v.Female:
+		url = set.BackFemale
+	case v.Back:
+		url = set.BackDefault
+	case v.Shiny && v.Female:
+		url = set.FrontShinyFemale
+	case v.Shiny:
+		url = set.FrontShiny
+	case v.Female:
+		url = set.FrontFemale
+	default:
+		url = set.FrontDefault
+	}
+
+	if url == "" {
+		name := v.String()
+		if name == "" {
+			name = "default"
+		}
+		return "", fmt.Errorf("%s", cmdutils.FormatError(fmt.Sprintf("This Pokémon has no %s sprite.", name)))
+	}
+
+	return url, nil
+}
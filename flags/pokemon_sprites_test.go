@@ -0,0 +1,77 @@
+package flags
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/structs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpriteVariant(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    SpriteVariant
+		wantErr string
+	}{
+		{value: "", want: SpriteVariant{Style: SpriteStyleDefault}},
+		{value: "shiny", want: SpriteVariant{Style: SpriteStyleDefault, Shiny: true}},
+		{value: "Shiny, Back", want: SpriteVariant{Style: SpriteStyleDefault, Shiny: true, Back: true}},
+		{value: "female,animated", want: SpriteVariant{Style: SpriteStyleAnimated, Female: true}},
+		{value: "official-artwork", want: SpriteVariant{Style: SpriteStyleArtwork}},
+		{value: "home,shiny", want: SpriteVariant{Style: SpriteStyleHome, Shiny: true}},
+		{value: "home,artwork", wantErr: "Only one of default, artwork, home or animated"},
+		{value: "gold", wantErr: "Invalid sprite option 'gold'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSpriteVariant(tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSpriteVariantURL(t *testing.T) {
+	var sprites structs.PokemonSprites
+	sprites.FrontDefault = "front.png"
+	sprites.FrontShiny = "front-shiny.png"
+	sprites.BackShinyFemale = "back-shiny-female.png"
+	sprites.Other.OfficialArtwork.FrontShiny = "artwork-shiny.png"
+	sprites.Other.Home.FrontFemale = "home-female.png"
+	sprites.Versions.GenerationV.BlackWhite.Animated.BackDefault = "animated-back.gif"
+
+	tests := []struct {
+		variant SpriteVariant
+		want    string
+	}{
+		{SpriteVariant{Style: SpriteStyleDefault}, "front.png"},
+		{SpriteVariant{Style: SpriteStyleDefault, Shiny: true}, "front-shiny.png"},
+		{SpriteVariant{Style: SpriteStyleDefault, Shiny: true, Female: true, Back: true}, "back-shiny-female.png"},
+		{SpriteVariant{Style: SpriteStyleArtwork, Shiny: true}, "artwork-shiny.png"},
+		{SpriteVariant{Style: SpriteStyleHome, Female: true}, "home-female.png"},
+		{SpriteVariant{Style: SpriteStyleAnimated, Back: true}, "animated-back.gif"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.variant.URL(sprites)
+		require.NoError(t, err, tt.variant.String())
+		assert.Equal(t, tt.want, got)
+	}
+
+	_, err := SpriteVariant{Style: SpriteStyleArtwork, Back: true}.URL(sprites)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "no back artwork sprite"), err.Error())
+}
+
+func TestSpriteVariantString(t *testing.T) {
+	assert.Equal(t, "", SpriteVariant{Style: SpriteStyleDefault}.String())
+	assert.Equal(t, "shiny female back animated", SpriteVariant{Style: SpriteStyleAnimated, Shiny: true, Female: true, Back: true}.String())
+}
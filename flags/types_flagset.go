@@ -0,0 +1,54 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+	flag "github.com/spf13/pflag"
+)
+
+type TypesFlags struct {
+	FlagSet *flag.FlagSet
+	Defend  *[]string
+	Tera    *string
+	Ability *string
+	Resists *[]string
+	Gen     *int
+	Pokemon *string
+	Moves   *string
+	Filter  *string
+	Page    *int
+}
+
+func SetupTypesFlagSet() *TypesFlags {
+	tf := &TypesFlags{}
+	tf.FlagSet = flag.NewFlagSet("typesFlags", flag.ContinueOnError)
+
+	tf.Defend = tf.FlagSet.StringSliceP("defend", "d", nil, "One or two defending types, e.g. dragon,flying.")
+	tf.Tera = tf.FlagSet.StringP("tera", "t", "", "With --defend, the Tera type, including stellar.")
+	tf.Ability = tf.FlagSet.StringP("ability", "a", "", "With --defend, an ability such as levitate or thick-fat.")
+	tf.Resists = tf.FlagSet.StringSliceP("resists", "r", nil, "List the type combos that resist all of these types.")
+	tf.Gen = tf.FlagSet.IntP("gen", "g", 9, "Generation of the type chart, from 1 to 9.")
+	tf.Pokemon = tf.FlagSet.StringP("pokemon", "p", "", "List the Pokémon of a type with their other type and BST.")
+	tf.Moves = tf.FlagSet.StringP("moves", "m", "", "List the moves of a type with power, accuracy and category.")
+	tf.Filter = tf.FlagSet.StringP("filter", "f", "", "With --pokemon or --moves, only rows containing this text.")
+	tf.Page = tf.FlagSet.Int("page", 1, "With --pokemon or --moves, the page to show.")
+
+	tf.FlagSet.Usage = func() {
+		helpMessage := styling.HelpBorder.Render("poke-cli types [flags]\n\n",
+			styling.StyleBold.Render("FLAGS:"),
+			fmt.Sprintf("\n\t%-30s %s", "-d, --defend", "One or two defending types, e.g. dragon,flying."),
+			fmt.Sprintf("\n\t%-30s %s", "-t, --tera", "With --defend, the Tera type, including stellar."),
+			fmt.Sprintf("\n\t%-30s %s", "-a, --ability", "With --defend, an ability such as levitate or thick-fat."),
+			fmt.Sprintf("\n\t%-30s %s", "-r, --resists", "List the type combos that resist all of these types."),
+			fmt.Sprintf("\n\t%-30s %s", "-g, --gen", "Generation of the type chart, from 1 to 9."),
+			fmt.Sprintf("\n\t%-30s %s", "-p, --pokemon", "List the Pokémon of a type with their other type and BST."),
+			fmt.Sprintf("\n\t%-30s %s", "-m, --moves", "List the moves of a type with power, accuracy and category."),
+			fmt.Sprintf("\n\t%-30s %s", "-f, --filter", "With --pokemon or --moves, only rows containing this text."),
+			fmt.Sprintf("\n\t%-30s %s", "--page", "With --pokemon or --moves, the page to show."),
+		)
+		fmt.Println(helpMessage)
+	}
+
+	return tf
+}
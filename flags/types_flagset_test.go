@@ -0,0 +1,58 @@
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupTypesFlagSet(t *testing.T) {
+	tf := SetupTypesFlagSet()
+
+	assert.NotNil(t, tf, "Flag set should not be nil")
+	assert.Equal(t, "typesFlags", tf.FlagSet.Name(), "Flag set name should be 'typesFlags'")
+
+	flagTests := []struct {
+		flag     interface{}
+		expected interface{}
+		name     string
+	}{
+		{tf.Defend, []string(nil), "Defend flag should default to empty"},
+		{tf.Tera, "", "Tera flag should default to empty"},
+		{tf.Ability, "", "Ability flag should default to empty"},
+		{tf.Resists, []string(nil), "Resists flag should default to empty"},
+		{tf.Gen, 9, "Gen flag should default to 9"},
+		{tf.Pokemon, "", "Pokemon flag should default to empty"},
+		{tf.Moves, "", "Moves flag should default to empty"},
+		{tf.Filter, "", "Filter flag should default to empty"},
+		{tf.Page, 1, "Page flag should default to 1"},
+	}
+
+	for _, tt := range flagTests {
+		assert.NotNil(t, tt.flag, tt.name)
+		assert.Equal(t, tt.expected, reflect.ValueOf(tt.flag).Elem().Interface(), tt.name)
+	}
+}
+
+func TestTypesFlagSetParse(t *testing.T) {
+	tf := SetupTypesFlagSet()
+	err := tf.FlagSet.Parse([]string{"-d", "dragon,flying", "--tera=steel", "-a", "levitate", "-g", "5"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"dragon", "flying"}, *tf.Defend)
+	assert.Equal(t, "steel", *tf.Tera)
+	assert.Equal(t, "levitate", *tf.Ability)
+	assert.Equal(t, 5, *tf.Gen)
+
+	tf = SetupTypesFlagSet()
+	require.NoError(t, tf.FlagSet.Parse([]string{"--resists", "fire", "-r", "water"}))
+	assert.Equal(t, []string{"fire", "water"}, *tf.Resists)
+
+	tf = SetupTypesFlagSet()
+	require.NoError(t, tf.FlagSet.Parse([]string{"-p", "fire", "-f", "flying", "--page", "2"}))
+	assert.Equal(t, "fire", *tf.Pokemon)
+	assert.Equal(t, "flying", *tf.Filter)
+	assert.Equal(t, 2, *tf.Page)
+}
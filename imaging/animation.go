@@ -0,0 +1,137 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// defaultFrameDelay is used for GIF frames that don't set a delay.
+const defaultFrameDelay = 100 * time.Millisecond
+
+// kittyChunkSize is the largest payload the kitty graphics protocol accepts per escape code.
+const kittyChunkSize = 4096
+
+// Animation is a decoded animated image with every frame composited onto the full canvas.
+type Animation struct {
+	Frames []image.Image
+	Delays []time.Duration
+}
+
+// DecodeAnimation decodes an animated GIF, applying each frame's disposal
+// method so every frame can be drawn on its own.
+func DecodeAnimation(data []byte) (*Animation, error) {
+	g, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode animation: %w", err)
+	}
+	if len(g.Image) == 0 {
+		return nil, errors.New("animation has no frames")
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, g.Config.Width, g.Config.Height))
+	anim := &Animation{}
+
+	for i, frame := range g.Image {
+		var disposal byte
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
+		}
+
+		var previous *image.RGBA
+		if disposal == gif.DisposalPrevious {
+			previous = cloneRGBA(canvas)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		anim.Frames = append(anim.Frames, cloneRGBA(canvas))
+
+		delay := defaultFrameDelay
+		if i < len(g.Delay) && g.Delay[i] > 0 {
+			delay = time.Duration(g.Delay[i]) * 10 * time.Millisecond
+		}
+		anim.Delays = append(anim.Delays, delay)
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	return anim, nil
+}
+
+func cloneRGBA(src *image.RGBA) *image.RGBA {
+	dst := image.NewRGBA(src.Bounds())
+	copy(dst.Pix, src.Pix)
+	return dst
+}
+
+// RenderAnimation plays the animation in terminals that speak the kitty
+// graphics protocol. Every other protocol gets the first frame.
+func RenderAnimation(anim *Animation, p Protocol, opts Options) (string, error) {
+	if p != Kitty || len(anim.Frames) == 1 {
+		return Render(anim.Frames[0], p, opts)
+	}
+	return kittyAnimation(anim, opts, rand.Uint32N(1<<24)+1) // #nosec G404
+}
+
+// kittyAnimation sends the first frame as a normal image, appends the rest as
+// animation frames and asks the terminal to loop them forever. Frames are sent
+// as PNG, which keeps upscaled pixel art small.
+func kittyAnimation(anim *Animation, opts Options, id uint32) (string, error) {
+	var buf strings.Builder
+
+	for i, frame := range anim.Frames {
+		var encoded bytes.Buffer
+		if err := png.Encode(&encoded, resize(frame, opts)); err != nil {
+			return "", fmt.Errorf("failed to encode kitty frame: %w", err)
+		}
+
+		control := fmt.Sprintf("a=T,f=100,i=%d,q=2", id)
+		if i > 0 {
+			control = fmt.Sprintf("a=f,f=100,i=%d,z=%d,q=2", id, anim.Delays[i].Milliseconds())
+		}
+		writeKittyChunks(&buf, control, encoded.Bytes())
+	}
+
+	// The first frame was sent as a plain image, so its delay is set separately
+	fmt.Fprintf(&buf, "\x1b_Ga=a,i=%d,r=1,z=%d,q=2\x1b\\", id, anim.Delays[0].Milliseconds())
+	// s=3 runs the animation and v=1 loops it forever
+	fmt.Fprintf(&buf, "\x1b_Ga=a,i=%d,s=3,v=1,q=2\x1b\\", id)
+
+	return buf.String(), nil
+}
+
+func writeKittyChunks(buf *strings.Builder, control string, payload []byte) {
+	encoded := base64.StdEncoding.EncodeToString(payload)
+
+	for first := true; first || len(encoded) > 0; first = false {
+		chunk := encoded
+		if len(chunk) > kittyChunkSize {
+			chunk = chunk[:kittyChunkSize]
+		}
+		encoded = encoded[len(chunk):]
+
+		more := 0
+		if len(encoded) > 0 {
+			more = 1
+		}
+
+		if first {
+			fmt.Fprintf(buf, "\x1b_G%s,m=%d;%s\x1b\\", control, more, chunk)
+		} else {
+			fmt.Fprintf(buf, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"strings"
+	"testing"
+	"time"
+)
+
+// twoFrameGIF has a red first frame and a second frame that only covers the
+// left half in blue, so compositing must keep the red right half.
+func twoFrameGIF(t *testing.T) []byte {
+	t.Helper()
+
+	first := image.NewPaletted(image.Rect(0, 0, 4, 4), palette.Plan9)
+	second := image.NewPaletted(image.Rect(0, 0, 2, 4), palette.Plan9)
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			first.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+		for x := 0; x < 2; x++ {
+			second.Set(x, y, color.RGBA{B: 255, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	err := gif.EncodeAll(&buf, &gif.GIF{
+		Image:    []*image.Paletted{first, second},
+		Delay:    []int{5, 0},
+		Disposal: []byte{gif.DisposalNone, gif.DisposalNone},
+		Config:   image.Config{Width: 4, Height: 4, ColorModel: color.Palette(palette.Plan9)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeAnimation(t *testing.T) {
+	anim, err := DecodeAnimation(twoFrameGIF(t))
+	if err != nil {
+		t.Fatalf("DecodeAnimation() error = %v", err)
+	}
+
+	if len(anim.Frames) != 2 {
+		t.Fatalf("expected 2 frames, got %d", len(anim.Frames))
+	}
+	if anim.Delays[0] != 50*time.Millisecond || anim.Delays[1] != defaultFrameDelay {
+		t.Errorf("unexpected delays: %v", anim.Delays)
+	}
+
+	second := anim.Frames[1]
+	if r, _, b, _ := second.At(0, 0).RGBA(); b == 0 || r != 0 {
+		t.Errorf("left half of frame 2 should be blue, got %v", second.At(0, 0))
+	}
+	if r, _, _, _ := second.At(3, 0).RGBA(); r == 0 {
+		t.Errorf("right half of frame 2 should keep frame 1's red, got %v", second.At(3, 0))
+	}
+}
+
+func TestDecodeAnimation_NotGIF(t *testing.T) {
+	if _, err := DecodeAnimation([]byte("nope")); err == nil {
+		t.Error("expected an error for data that isn't a GIF")
+	}
+}
+
+func TestKittyAnimation(t *testing.T) {
+	anim, err := DecodeAnimation(twoFrameGIF(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := kittyAnimation(anim, Options{Width: 8, Height: 8}, 42)
+	if err != nil {
+		t.Fatalf("kittyAnimation() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"\x1b_Ga=T,f=100,i=42,q=2,m=0;",
+		"\x1b_Ga=f,f=100,i=42,z=100,q=2,m=0;",
+		"\x1b_Ga=a,i=42,r=1,z=50,q=2\x1b\\",
+		"\x1b_Ga=a,i=42,s=3,v=1,q=2\x1b\\",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("kittyAnimation() output missing %q", want)
+		}
+	}
+}
+
+func TestRenderAnimation_FallsBackToFirstFrame(t *testing.T) {
+	anim, err := DecodeAnimation(twoFrameGIF(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := RenderAnimation(anim, HalfBlock, Options{Width: 4, Height: 4})
+	if err != nil {
+		t.Fatalf("RenderAnimation() error = %v", err)
+	}
+	if strings.Contains(got, "\x1b_G") || got == "" {
+		t.Errorf("expected a half-block frame, got %.40q", got)
+	}
+}
+
+func TestWriteKittyChunks(t *testing.T) {
+	var buf strings.Builder
+	writeKittyChunks(&buf, "a=T", bytes.Repeat([]byte{1}, kittyChunkSize))
+
+	out := buf.String()
+	if n := strings.Count(out, "\x1b_G"); n != 2 {
+		t.Fatalf("expected 2 chunks, got %d", n)
+	}
+	if !strings.HasPrefix(out, "\x1b_Ga=T,m=1;") || !strings.Contains(out, "\x1b_Gm=0;") {
+		t.Errorf("unexpected chunk markers: %.40q", out)
+	}
+}
@@ -0,0 +1,182 @@
+// detect.go works out which image protocols the terminal supports.
+// Environment variables give a first guess; when attached to a terminal, the
+// guess is confirmed with real queries (kitty graphics, XTGETTCAP and DA1).
+
+package imaging
+
+import (
+	"encoding/hex"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// ProtocolEnv forces a protocol, e.g. POKE_CLI_IMAGE_PROTOCOL=braille.
+const ProtocolEnv = "POKE_CLI_IMAGE_PROTOCOL"
+
+type Capabilities struct {
+	Kitty  bool
+	Sixel  bool
+	ITerm2 bool
+}
+
+var (
+	detectOnce sync.Once
+	detected   Capabilities
+
+	// queryFunc is swapped out in tests.
+	queryFunc = queryTerminal
+)
+
+// Detect returns the terminal's image capabilities. The result is cached, so
+// call it before starting a Bubble Tea program: the queries read from the
+// terminal and must not race with the program's input reader.
+func Detect() Capabilities {
+	detectOnce.Do(func() {
+		detected = detect(os.Getenv, queryFunc)
+	})
+	return detected
+}
+
+func detect(getenv func(string) string, query func() []byte) Capabilities {
+	c := fromEnv(getenv)
+	if resp := query(); len(resp) > 0 {
+		c = c.merge(parseResponses(resp))
+	}
+	return c
+}
+
+func (c Capabilities) merge(o Capabilities) Capabilities {
+	return Capabilities{
+		Kitty:  c.Kitty || o.Kitty,
+		Sixel:  c.Sixel || o.Sixel,
+		ITerm2: c.ITerm2 || o.ITerm2,
+	}
+}
+
+// Best picks the richest protocol the capabilities allow.
+func (c Capabilities) Best() Protocol {
+	switch {
+	case c.Kitty:
+		return Kitty
+	case c.ITerm2:
+		return ITerm2
+	case c.Sixel:
+		return Sixel
+	default:
+		return HalfBlock
+	}
+}
+
+// Best returns the protocol to draw with on stdout. Graphics protocols are
+// only used when stdout is a terminal so piped output stays plain text.
+func Best() Protocol {
+	if p, ok := ParseProtocol(os.Getenv(ProtocolEnv)); ok {
+		return p
+	}
+	if !term.IsTerminal(int(os.Stdout.Fd())) { // #nosec G115
+		return HalfBlock
+	}
+	return Detect().Best()
+}
+
+// BestText returns the text protocol to use for images drawn inside lipgloss
+// layouts, where escape-sequence graphics can't be positioned.
+func BestText() Protocol {
+	if p, ok := ParseProtocol(os.Getenv(ProtocolEnv)); ok && !p.Graphics() {
+		return p
+	}
+	return HalfBlock
+}
+
+func fromEnv(getenv func(string) string) Capabilities {
+	var c Capabilities
+
+	termProgram := strings.ToLower(getenv("TERM_PROGRAM"))
+	term := strings.ToLower(getenv("TERM"))
+
+	// Kitty graphics protocol
+	if getenv("KITTY_WINDOW_ID") != "" {
+		c.Kitty = true
+	}
+	switch termProgram {
+	case "kitty", "ghostty", "wezterm":
+		c.Kitty = true
+	}
+	if strings.Contains(term, "kitty") || strings.Contains(term, "ghostty") {
+		c.Kitty = true
+	}
+
+	// Sixel graphics protocol
+	if getenv("WT_SESSION") != "" {
+		c.Sixel = true
+	}
+	switch termProgram {
+	case "iterm.app", "wezterm", "konsole", "tabby", "rio":
+		c.Sixel = true
+	}
+	if term == "foot" || strings.HasPrefix(term, "foot-") || strings.Contains(term, "sixel") {
+		c.Sixel = true
+	}
+
+	// iTerm2 inline images protocol
+	switch termProgram {
+	case "iterm.app", "wezterm":
+		c.ITerm2 = true
+	}
+	if strings.EqualFold(getenv("LC_TERMINAL"), "iTerm2") {
+		c.ITerm2 = true
+	}
+
+	return c
+}
+
+var (
+	kittyReply = regexp.MustCompile(`\x1b_Gi=` + kittyQueryID + `;OK`)
+	tcapReply  = regexp.MustCompile(`\x1bP1\+r([0-9A-Fa-f]+)=([0-9A-Fa-f]*)\x1b\\`)
+	da1Reply   = regexp.MustCompile(`\x1b\[\?([0-9;]*)c`)
+)
+
+// parseResponses reads the replies to the queries sent by queryTerminal.
+func parseResponses(resp []byte) Capabilities {
+	var c Capabilities
+
+	if kittyReply.Match(resp) {
+		c.Kitty = true
+	}
+
+	// XTGETTCAP reports the terminal name (TN) in hex.
+	for _, m := range tcapReply.FindAllSubmatch(resp, -1) {
+		key, err := hex.DecodeString(string(m[1]))
+		if err != nil || string(key) != "TN" {
+			continue
+		}
+		value, err := hex.DecodeString(string(m[2]))
+		if err != nil {
+			continue
+		}
+		name := strings.ToLower(string(value))
+		switch {
+		case strings.Contains(name, "kitty"), strings.Contains(name, "ghostty"):
+			c.Kitty = true
+		case strings.Contains(name, "wezterm"):
+			c.Kitty, c.Sixel, c.ITerm2 = true, true, true
+		case strings.Contains(name, "iterm"):
+			c.ITerm2 = true
+		}
+	}
+
+	// Attribute 4 in the DA1 reply means sixel graphics.
+	if m := da1Reply.FindSubmatch(resp); m != nil {
+		for _, attr := range strings.Split(string(m[1]), ";") {
+			if attr == "4" {
+				c.Sixel = true
+			}
+		}
+	}
+
+	return c
+}
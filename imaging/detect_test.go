@@ -0,0 +1,358 @@
+package imaging
+
+import (
+	"testing"
+)
+
+func envFunc(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestFromEnv_Kitty(t *testing.T) {
+	tests := []struct {
+		name        string
+		envVars     map[string]string
+		wantSupport bool
+	}{
+		{
+			name: "kitty terminal via KITTY_WINDOW_ID",
+			envVars: map[string]string{
+				"KITTY_WINDOW_ID": "1",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "kitty via TERM_PROGRAM",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "kitty",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "kitty via TERM_PROGRAM uppercase",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "KITTY",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "ghostty via TERM_PROGRAM",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "ghostty",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "ghostty via TERM_PROGRAM uppercase",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "Ghostty",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "wezterm via TERM_PROGRAM",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "WezTerm",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "ghostty via TERM variable",
+			envVars: map[string]string{
+				"TERM": "xterm-ghostty",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "kitty via TERM variable",
+			envVars: map[string]string{
+				"TERM": "xterm-kitty",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "unsupported terminal - Apple Terminal",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "Apple_Terminal",
+				"TERM":         "xterm-256color",
+			},
+			wantSupport: false,
+		},
+		{
+			name: "unsupported terminal - iTerm2",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "iTerm.app",
+				"TERM":         "xterm-256color",
+			},
+			wantSupport: false,
+		},
+		{
+			name: "unsupported terminal - GNOME Terminal",
+			envVars: map[string]string{
+				"TERM": "xterm",
+			},
+			wantSupport: false,
+		},
+		{
+			name:        "no environment variables set",
+			envVars:     map[string]string{},
+			wantSupport: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fromEnv(envFunc(tt.envVars)).Kitty
+			if got != tt.wantSupport {
+				t.Errorf("fromEnv().Kitty = %v, want %v", got, tt.wantSupport)
+			}
+		})
+	}
+}
+
+func TestFromEnv_Sixel(t *testing.T) {
+	tests := []struct {
+		name        string
+		envVars     map[string]string
+		wantSupport bool
+	}{
+		{
+			name: "iterm2 via TERM_PROGRAM",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "iTerm.app",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "wezterm via TERM_PROGRAM",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "WezTerm",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "wezterm lowercase",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "wezterm",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "rio via TERM_PROGRAM",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "rio",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "konsole via TERM_PROGRAM",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "Konsole",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "foot via TERM",
+			envVars: map[string]string{
+				"TERM": "foot",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "foot with suffix",
+			envVars: map[string]string{
+				"TERM": "foot-extra",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "xterm-sixel via TERM",
+			envVars: map[string]string{
+				"TERM": "xterm-sixel",
+			},
+			wantSupport: true,
+		},
+		{
+			name: "unsupported terminal - Apple Terminal",
+			envVars: map[string]string{
+				"TERM_PROGRAM": "Apple_Terminal",
+				"TERM":         "xterm-256color",
+			},
+			wantSupport: false,
+		},
+		{
+			name: "unsupported terminal - Alacritty",
+			envVars: map[string]string{
+				"TERM": "alacritty",
+			},
+			wantSupport: false,
+		},
+		{
+			name: "unsupported terminal - standard xterm",
+			envVars: map[string]string{
+				"TERM": "xterm",
+			},
+			wantSupport: false,
+		},
+		{
+			name: "unsupported terminal - xterm-256color",
+			envVars: map[string]string{
+				"TERM": "xterm-256color",
+			},
+			wantSupport: false,
+		},
+		{
+			name:        "no environment variables set",
+			envVars:     map[string]string{},
+			wantSupport: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fromEnv(envFunc(tt.envVars)).Sixel
+			if got != tt.wantSupport {
+				t.Errorf("fromEnv().Sixel = %v, want %v", got, tt.wantSupport)
+			}
+		})
+	}
+}
+
+func TestFromEnv_ITerm2(t *testing.T) {
+	tests := []struct {
+		name        string
+		envVars     map[string]string
+		wantSupport bool
+	}{
+		{
+			name:        "iTerm2 via TERM_PROGRAM",
+			envVars:     map[string]string{"TERM_PROGRAM": "iTerm.app"},
+			wantSupport: true,
+		},
+		{
+			name:        "iTerm2 via LC_TERMINAL over ssh",
+			envVars:     map[string]string{"LC_TERMINAL": "iTerm2", "TERM": "xterm-256color"},
+			wantSupport: true,
+		},
+		{
+			name:        "wezterm via TERM_PROGRAM",
+			envVars:     map[string]string{"TERM_PROGRAM": "WezTerm"},
+			wantSupport: true,
+		},
+		{
+			name:        "unsupported terminal - kitty",
+			envVars:     map[string]string{"TERM_PROGRAM": "kitty"},
+			wantSupport: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fromEnv(envFunc(tt.envVars)).ITerm2
+			if got != tt.wantSupport {
+				t.Errorf("fromEnv().ITerm2 = %v, want %v", got, tt.wantSupport)
+			}
+		})
+	}
+}
+
+func TestParseResponses(t *testing.T) {
+	tests := []struct {
+		name string
+		resp string
+		want Capabilities
+	}{
+		{
+			name: "kitty graphics reply",
+			resp: "\x1b_Gi=31;OK\x1b\\\x1b[?62;22c",
+			want: Capabilities{Kitty: true},
+		},
+		{
+			name: "kitty graphics error reply",
+			resp: "\x1b_Gi=31;ENOTSUPPORTED:no\x1b\\\x1b[?62;22c",
+			want: Capabilities{},
+		},
+		{
+			name: "sixel in DA1 attributes",
+			resp: "\x1b[?62;4;22c",
+			want: Capabilities{Sixel: true},
+		},
+		{
+			name: "DA1 attribute 42 is not sixel",
+			resp: "\x1b[?62;42c",
+			want: Capabilities{},
+		},
+		{
+			name: "XTGETTCAP names WezTerm",
+			resp: "\x1bP1+r544e=57657a5465726d\x1b\\\x1b[?65;1;9c",
+			want: Capabilities{Kitty: true, Sixel: true, ITerm2: true},
+		},
+		{
+			name: "XTGETTCAP names iTerm2",
+			resp: "\x1bP1+r544e=695465726d32\x1b\\\x1b[?62;4c",
+			want: Capabilities{Sixel: true, ITerm2: true},
+		},
+		{
+			name: "XTGETTCAP not understood",
+			resp: "\x1bP0+r\x1b\\\x1b[?1;2c",
+			want: Capabilities{},
+		},
+		{
+			name: "no reply",
+			resp: "",
+			want: Capabilities{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseResponses([]byte(tt.resp)); got != tt.want {
+				t.Errorf("parseResponses() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetect_MergesEnvAndQuery(t *testing.T) {
+	env := envFunc(map[string]string{"TERM_PROGRAM": "iTerm.app"})
+	query := func() []byte { return []byte("\x1b_Gi=31;OK\x1b\\\x1b[?62;22c") }
+
+	got := detect(env, query)
+	want := Capabilities{Kitty: true, Sixel: true, ITerm2: true}
+	if got != want {
+		t.Errorf("detect() = %+v, want %+v", got, want)
+	}
+	if got.Best() != Kitty {
+		t.Errorf("Best() = %v, want Kitty", got.Best())
+	}
+}
+
+func TestCapabilitiesBest(t *testing.T) {
+	tests := []struct {
+		caps Capabilities
+		want Protocol
+	}{
+		{Capabilities{Kitty: true, Sixel: true, ITerm2: true}, Kitty},
+		{Capabilities{Sixel: true, ITerm2: true}, ITerm2},
+		{Capabilities{Sixel: true}, Sixel},
+		{Capabilities{}, HalfBlock},
+	}
+
+	for _, tt := range tests {
+		if got := tt.caps.Best(); got != tt.want {
+			t.Errorf("%+v.Best() = %v, want %v", tt.caps, got, tt.want)
+		}
+	}
+}
+
+func TestBest_Override(t *testing.T) {
+	t.Setenv(ProtocolEnv, "braille")
+	if got := Best(); got != Braille {
+		t.Errorf("Best() = %v, want Braille", got)
+	}
+
+	t.Setenv(ProtocolEnv, "sixel")
+	if got := BestText(); got != HalfBlock {
+		t.Errorf("BestText() with a graphics override = %v, want Half-block", got)
+	}
+}
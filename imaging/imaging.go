@@ -0,0 +1,161 @@
+// Package imaging renders images in the terminal. It detects which graphics
+// protocol the terminal speaks (Kitty, Sixel or iTerm2 inline images) and
+// falls back to Unicode half-block or braille art everywhere else.
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi/sixel"
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/dolmen-go/kittyimg"
+	"golang.org/x/image/draw"
+)
+
+type Protocol string
+
+const (
+	Kitty     Protocol = "Kitty"
+	Sixel     Protocol = "Sixel"
+	ITerm2    Protocol = "iTerm2"
+	HalfBlock Protocol = "Half-block"
+	Braille   Protocol = "Braille"
+)
+
+// Graphics reports whether the protocol draws pixels rather than text.
+func (p Protocol) Graphics() bool {
+	return p == Kitty || p == Sixel || p == ITerm2
+}
+
+// ParseProtocol maps a user-facing name such as "kitty" or "halfblock" to a Protocol.
+func ParseProtocol(name string) (Protocol, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "kitty":
+		return Kitty, true
+	case "sixel":
+		return Sixel, true
+	case "iterm2", "iterm":
+		return ITerm2, true
+	case "halfblock", "half-block":
+		return HalfBlock, true
+	case "braille":
+		return Braille, true
+	}
+	return "", false
+}
+
+// Options control the size of the rendered image. Width and Height are pixels
+// for graphics protocols and sub-cell dots for text protocols.
+type Options struct {
+	Width  int
+	Height int
+	// Smooth resizes with Catmull-Rom instead of nearest neighbor, which suits
+	// photos and card scans better than pixel-art sprites.
+	Smooth bool
+}
+
+var httpClient = connections.NewDefaultHTTPClient()
+
+// Download fetches the raw bytes of an image, failing when there are more
+// than maxBytes.
+func Download(imageURL string, maxBytes int64) ([]byte, error) {
+	parsedURL, err := url.Parse(imageURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return nil, errors.New("image is not available from the API")
+	}
+
+	resp, err := httpClient.Get(imageURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("non-200 response: %d", resp.StatusCode)
+	}
+
+	// Read body into memory first to avoid timeout during decode
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image data: %w", err)
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, fmt.Errorf("image exceeds %d bytes", maxBytes)
+	}
+
+	return body, nil
+}
+
+// Fetch downloads and decodes an image of at most maxBytes.
+func Fetch(imageURL string, maxBytes int64) (image.Image, error) {
+	body, err := Download(imageURL, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	return img, nil
+}
+
+// Render draws img with the given protocol.
+func Render(img image.Image, p Protocol, opts Options) (string, error) {
+	switch p {
+	case HalfBlock:
+		return halfBlock(resize(img, opts)), nil
+	case Braille:
+		return braille(resize(img, opts)), nil
+	}
+
+	resized := resize(img, opts)
+	var buf bytes.Buffer
+
+	switch p {
+	case Kitty:
+		if err := kittyimg.Fprint(&buf, resized); err != nil {
+			return "", fmt.Errorf("failed to encode kitty image: %w", err)
+		}
+	case Sixel:
+		buf.WriteString("\x1bPq")
+		if err := new(sixel.Encoder).Encode(&buf, resized); err != nil {
+			return "", fmt.Errorf("failed to encode sixel: %w", err)
+		}
+		buf.WriteString("\x1b\\")
+	case ITerm2:
+		var encoded bytes.Buffer
+		if err := png.Encode(&encoded, resized); err != nil {
+			return "", fmt.Errorf("failed to encode iTerm2 image: %w", err)
+		}
+		b := resized.Bounds()
+		fmt.Fprintf(&buf, "\x1b]1337;File=inline=1;size=%d;width=%dpx;height=%dpx;preserveAspectRatio=1:%s\a",
+			encoded.Len(), b.Dx(), b.Dy(), base64.StdEncoding.EncodeToString(encoded.Bytes()))
+	default:
+		return "", fmt.Errorf("unknown image protocol: %q", p)
+	}
+
+	return buf.String(), nil
+}
+
+func resize(img image.Image, opts Options) image.Image {
+	if opts.Width <= 0 || opts.Height <= 0 {
+		return img
+	}
+	if !opts.Smooth {
+		return nearest(img, opts.Width, opts.Height)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, opts.Width, opts.Height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Over, nil)
+	return dst
+}
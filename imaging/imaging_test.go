@@ -0,0 +1,168 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func solidImage(w, h int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestResize(t *testing.T) {
+	testImg := solidImage(100, 100, color.RGBA{R: 255, A: 255})
+
+	tests := []struct {
+		name       string
+		opts       Options
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "smooth to smaller dimensions", opts: Options{Width: 50, Height: 50, Smooth: true}, wantWidth: 50, wantHeight: 50},
+		{name: "smooth to card dimensions", opts: Options{Width: 500, Height: 675, Smooth: true}, wantWidth: 500, wantHeight: 675},
+		{name: "nearest neighbor", opts: Options{Width: 30, Height: 20}, wantWidth: 30, wantHeight: 20},
+		{name: "zero size keeps original", opts: Options{}, wantWidth: 100, wantHeight: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bounds := resize(testImg, tt.opts).Bounds()
+			if bounds.Dx() != tt.wantWidth || bounds.Dy() != tt.wantHeight {
+				t.Errorf("resize() = %dx%d, want %dx%d", bounds.Dx(), bounds.Dy(), tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestParseProtocol(t *testing.T) {
+	tests := map[string]Protocol{
+		"kitty":      Kitty,
+		"Sixel":      Sixel,
+		"iterm2":     ITerm2,
+		"halfblock":  HalfBlock,
+		"half-block": HalfBlock,
+		" braille ":  Braille,
+	}
+	for name, want := range tests {
+		if got, ok := ParseProtocol(name); !ok || got != want {
+			t.Errorf("ParseProtocol(%q) = %v, %v; want %v", name, got, ok, want)
+		}
+	}
+
+	if _, ok := ParseProtocol("ascii"); ok {
+		t.Error("ParseProtocol(\"ascii\") should not be ok")
+	}
+}
+
+func TestRender(t *testing.T) {
+	img := solidImage(10, 10, color.RGBA{B: 255, A: 255})
+
+	tests := []struct {
+		protocol Protocol
+		prefix   string
+	}{
+		{Kitty, "\x1b_G"},
+		{Sixel, "\x1bPq"},
+		{ITerm2, "\x1b]1337;File=inline=1;"},
+		{HalfBlock, ""},
+		{Braille, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.protocol), func(t *testing.T) {
+			got, err := Render(img, tt.protocol, Options{Width: 8, Height: 8})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got == "" || !strings.HasPrefix(got, tt.prefix) {
+				t.Errorf("Render() = %.20q, want prefix %q", got, tt.prefix)
+			}
+		})
+	}
+
+	if _, err := Render(img, Protocol("ascii"), Options{}); err == nil {
+		t.Error("Render() with an unknown protocol should fail")
+	}
+}
+
+func TestRender_ITerm2Dimensions(t *testing.T) {
+	got, err := Render(solidImage(10, 10, color.White), ITerm2, Options{Width: 20, Height: 30})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(got, "width=20px;height=30px") || !strings.HasSuffix(got, "\a") {
+		t.Errorf("unexpected iTerm2 sequence: %.80q", got)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok.png":
+			w.Header().Set("Content-Type", "image/png")
+			_ = png.Encode(w, solidImage(4, 4, color.White))
+		case "/bad.png":
+			_, _ = w.Write([]byte("not an image"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	img, err := Fetch(server.URL+"/ok.png", 1024*1024)
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if img.Bounds().Dx() != 4 {
+		t.Errorf("Fetch() width = %d, want 4", img.Bounds().Dx())
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr string
+	}{
+		{"decode error", server.URL + "/bad.png", "failed to decode image"},
+		{"non-200", server.URL + "/missing.png", "non-200 response: 404"},
+		{"not http", "ftp://example.com/a.png", "image is not available from the API"},
+		{"empty", "", "image is not available from the API"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Fetch(tt.url, 1024*1024)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Fetch() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := Fetch(server.URL+"/ok.png", 16); err == nil || !strings.Contains(err.Error(), "exceeds 16 bytes") {
+		t.Errorf("Fetch() error = %v, want an error for an image over the limit", err)
+	}
+}
+
+func TestDownload_Limit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("12345678"))
+	}))
+	defer server.Close()
+
+	if body, err := Download(server.URL, 8); err != nil || string(body) != "12345678" {
+		t.Errorf("Download() = %q, %v, want the whole body at the limit", body, err)
+	}
+	if _, err := Download(server.URL, 7); err == nil || !strings.Contains(err.Error(), "exceeds 7 bytes") {
+		t.Errorf("Download() error = %v, want an error over the limit", err)
+	}
+}
@@ -0,0 +1,66 @@
+package imaging
+
+import (
+	"bytes"
+	"os"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	kittyQueryID = "31"
+
+	// kittyQuery asks for a 1x1 RGB image to be checked but not stored.
+	kittyQuery = "\x1b_Gi=" + kittyQueryID + ",s=1,v=1,a=q,t=d,f=24;AAAA\x1b\\"
+	// tcapQuery is XTGETTCAP for the terminal name (TN, hex encoded).
+	tcapQuery = "\x1bP+q544e\x1b\\"
+	// da1Query is sent last; every terminal answers it, so its reply marks
+	// the end of the responses.
+	da1Query = "\x1b[c"
+
+	queryTimeout = 150 * time.Millisecond
+)
+
+// queryTerminal sends the capability queries to the controlling terminal and
+// returns whatever it answered. It returns nil when there's no terminal or
+// the terminal can't be read with a deadline.
+func queryTerminal() []byte {
+	if !term.IsTerminal(int(os.Stdout.Fd())) { // #nosec G115
+		return nil
+	}
+
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return nil
+	}
+	defer tty.Close()
+
+	// Without a read deadline a terminal that ignores every query would
+	// block forever, so don't send anything.
+	if err := tty.SetReadDeadline(time.Now().Add(queryTimeout)); err != nil {
+		return nil
+	}
+
+	state, err := term.MakeRaw(int(tty.Fd())) // #nosec G115
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = term.Restore(int(tty.Fd()), state) }() // #nosec G115
+
+	if _, err := tty.WriteString(kittyQuery + tcapQuery + da1Query); err != nil {
+		return nil
+	}
+
+	var resp []byte
+	buf := make([]byte, 256)
+	for {
+		n, err := tty.Read(buf)
+		resp = append(resp, buf[:n]...)
+		if err != nil || da1Reply.Match(resp) {
+			break
+		}
+	}
+
+	return bytes.Clone(resp)
+}
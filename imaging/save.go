@@ -0,0 +1,93 @@
+package imaging
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var extensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Extension returns the file extension matching the image data, e.g. ".png".
+func Extension(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	ext, ok := extensions[contentType]
+	if !ok {
+		return "", fmt.Errorf("unsupported image type: %s", contentType)
+	}
+	return ext, nil
+}
+
+// FileName turns a display name such as "004/102 - Charizard" into a safe file
+// name without an extension.
+func FileName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			b.WriteRune(r)
+		case r == '/' || r == '\\':
+			b.WriteRune('-')
+		case r == ' ' || r == '_':
+			b.WriteRune('_')
+		case r > 127:
+			// Keep accented letters such as the é in Pokémon
+			b.WriteRune(r)
+		}
+	}
+	return strings.Trim(b.String(), ".")
+}
+
+// Save writes image data to dir/name with an extension matching its format and
+// returns the path written. The file is written to a temporary name first so
+// an interrupted save never leaves a partial image behind.
+func Save(dir string, name string, data []byte) (string, error) {
+	ext, err := Extension(data)
+	if err != nil {
+		return "", err
+	}
+
+	base := FileName(name)
+	if base == "" {
+		return "", errors.New("image name is empty")
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+base+"-*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	// CreateTemp makes the file private; saved images are meant to be shared
+	if err := tmp.Chmod(0o644); err != nil { // #nosec G302
+		tmp.Close()
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write image: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write image: %w", err)
+	}
+
+	path := filepath.Join(dir, base+ext)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to write image: %w", err)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,64 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileName(t *testing.T) {
+	tests := map[string]string{
+		"004/102 - Charizard":  "004-102_-_Charizard",
+		"Flabébé":              "Flabébé",
+		"Mr. Mime (base1)":     "Mr._Mime_base1",
+		"../../etc/passwd":     "-..-etc-passwd",
+		"  pikachu  ":          "pikachu",
+		"Farfetch'd: V-Union?": "Farfetchd_V-Union",
+	}
+	for name, want := range tests {
+		if got := FileName(name); got != want {
+			t.Errorf("FileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestSave(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "sprites")
+	path, err := Save(dir, "pikachu", buf.Bytes())
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if want := filepath.Join(dir, "pikachu.png"); path != want {
+		t.Errorf("Save() path = %q, want %q", path, want)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil || !bytes.Equal(got, buf.Bytes()) {
+		t.Errorf("saved file does not match: %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("expected only the saved image in %s, found %d entries", dir, len(entries))
+	}
+}
+
+func TestSave_Errors(t *testing.T) {
+	if _, err := Save(t.TempDir(), "pikachu", []byte("plain text")); err == nil {
+		t.Error("Save() should reject data that isn't an image")
+	}
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1)))
+	if _, err := Save(t.TempDir(), "???", buf.Bytes()); err == nil {
+		t.Error("Save() should reject an empty file name")
+	}
+}
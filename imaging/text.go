@@ -0,0 +1,147 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/digitalghost-dev/poke-cli/styling"
+	dimaging "github.com/disintegration/imaging"
+)
+
+func nearest(img image.Image, width, height int) image.Image {
+	return dimaging.Resize(img, width, height, dimaging.NearestNeighbor)
+}
+
+// halfBlock draws two pixel rows per line using "▀" with the top pixel as the
+// foreground and the bottom pixel as the background.
+func halfBlock(img image.Image) string {
+	b := img.Bounds()
+
+	imageWidth := b.Max.X
+	h := b.Max.Y
+
+	rowCount := (h - 1) / 2
+	if h%2 != 0 {
+		rowCount++
+	}
+	estimatedSize := (imageWidth * rowCount * 55) + rowCount
+
+	str := strings.Builder{}
+	str.Grow(estimatedSize)
+
+	// Cache for lipgloss styles to avoid recreating identical styles
+	styleCache := make(map[string]lipgloss.Style)
+
+	for heightCounter := 0; heightCounter < h-1; heightCounter += 2 {
+		for x := 0; x < imageWidth; x++ {
+			// Get the color of the current and next row's pixels
+			c1, _ := styling.MakeColor(img.At(x, heightCounter))
+			color1 := lipgloss.Color(c1.Hex())
+			c2, _ := styling.MakeColor(img.At(x, heightCounter+1))
+			color2 := lipgloss.Color(c2.Hex())
+
+			styleKey := c1.Hex() + "_" + c2.Hex()
+			style, exists := styleCache[styleKey]
+			if !exists {
+				style = lipgloss.NewStyle().Foreground(color1).Background(color2)
+				styleCache[styleKey] = style
+			}
+
+			str.WriteString(style.Render("▀"))
+		}
+
+		str.WriteString("\n")
+	}
+
+	return str.String()
+}
+
+// brailleDots maps a pixel offset inside a 2x4 cell to its braille dot bit.
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// braille draws a 2x4 block of pixels per cell. Transparent pixels are left
+// blank; on fully opaque images, pixels darker than the average are blank
+// instead so the shape still shows. Each cell takes the average colour of its
+// lit pixels.
+func braille(img image.Image) string {
+	b := img.Bounds()
+	opaque, mean := luminanceStats(img)
+
+	lit := func(x, y int) bool {
+		c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+		if c.A < 128 {
+			return false
+		}
+		return !opaque || luminance(c) >= mean
+	}
+
+	str := strings.Builder{}
+	styleCache := make(map[string]lipgloss.Style)
+
+	for y := b.Min.Y; y < b.Max.Y; y += 4 {
+		for x := b.Min.X; x < b.Max.X; x += 2 {
+			var dots rune
+			var r, g, bl, n int
+			for dy := 0; dy < 4; dy++ {
+				for dx := 0; dx < 2; dx++ {
+					px, py := x+dx, y+dy
+					if px >= b.Max.X || py >= b.Max.Y || !lit(px, py) {
+						continue
+					}
+					dots |= brailleDots[dy][dx]
+					c := color.NRGBAModel.Convert(img.At(px, py)).(color.NRGBA)
+					r, g, bl, n = r+int(c.R), g+int(c.G), bl+int(c.B), n+1
+				}
+			}
+
+			if n == 0 {
+				str.WriteString(" ")
+				continue
+			}
+
+			avg, _ := styling.MakeColor(color.NRGBA{R: uint8(r / n), G: uint8(g / n), B: uint8(bl / n), A: 255}) // #nosec G115
+			style, exists := styleCache[avg.Hex()]
+			if !exists {
+				style = lipgloss.NewStyle().Foreground(lipgloss.Color(avg.Hex()))
+				styleCache[avg.Hex()] = style
+			}
+			str.WriteString(style.Render(string(0x2800 + dots)))
+		}
+		str.WriteString("\n")
+	}
+
+	return str.String()
+}
+
+func luminance(c color.NRGBA) float64 {
+	return 0.299*float64(c.R) + 0.587*float64(c.G) + 0.114*float64(c.B)
+}
+
+// luminanceStats reports whether every pixel is opaque and the mean luminance.
+func luminanceStats(img image.Image) (bool, float64) {
+	b := img.Bounds()
+	opaque := true
+	var total float64
+	var count int
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A < 255 {
+				opaque = false
+			}
+			total += luminance(c)
+			count++
+		}
+	}
+	if count == 0 {
+		return opaque, 0
+	}
+	return opaque, total / float64(count)
+}
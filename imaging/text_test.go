@@ -0,0 +1,65 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/digitalghost-dev/poke-cli/styling"
+)
+
+func TestHalfBlock(t *testing.T) {
+	img := solidImage(4, 4, color.RGBA{R: 255, A: 255})
+
+	got := styling.StripANSI(halfBlock(img))
+	want := "▀▀▀▀\n▀▀▀▀\n"
+	if got != want {
+		t.Errorf("halfBlock() = %q, want %q", got, want)
+	}
+}
+
+func TestBraille_Transparency(t *testing.T) {
+	// Left column of each 2x4 cell is opaque, right column transparent.
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		img.Set(0, y, color.NRGBA{G: 255, A: 255})
+	}
+
+	got := styling.StripANSI(braille(img))
+	want := "⡇ \n"
+	if got != want {
+		t.Errorf("braille() = %q, want %q", got, want)
+	}
+}
+
+func TestBraille_OpaqueUsesLuminance(t *testing.T) {
+	// Top half white, bottom half black: only the bright dots are lit.
+	img := image.NewRGBA(image.Rect(0, 0, 2, 4))
+	for x := 0; x < 2; x++ {
+		img.Set(x, 0, color.White)
+		img.Set(x, 1, color.White)
+		img.Set(x, 2, color.Black)
+		img.Set(x, 3, color.Black)
+	}
+
+	got := styling.StripANSI(braille(img))
+	want := string(rune(0x2800|0x01|0x08|0x02|0x10)) + "\n"
+	if got != want {
+		t.Errorf("braille() = %q, want %q", got, want)
+	}
+}
+
+func TestBraille_PartialCells(t *testing.T) {
+	img := solidImage(3, 5, color.RGBA{B: 255, A: 255})
+
+	lines := strings.Split(strings.TrimSuffix(styling.StripANSI(braille(img)), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if n := len([]rune(line)); n != 2 {
+			t.Errorf("expected 2 cells per row, got %d in %q", n, line)
+		}
+	}
+}
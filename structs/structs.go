@@ -4,6 +4,10 @@ func (a AbilityJSONStruct) GetResourceName() string { return a.Name }
 
 func (i ItemJSONStruct) GetResourceName() string { return i.Name }
 
+func (m MachineJSONStruct) GetResourceName() string { return m.Item.Name }
+
+func (e EvolutionChainJSONStruct) GetResourceName() string { return e.Chain.Species.Name }
+
 func (m MoveJSONStruct) GetResourceName() string { return m.Name }
 
 func (p PokemonJSONStruct) GetResourceName() string { return p.Name }
@@ -23,6 +27,19 @@ type AbilityJSONStruct struct {
 		} `json:"language"`
 		ShortEffect string `json:"short_effect"`
 	} `json:"effect_entries"`
+	EffectChanges []struct {
+		EffectEntries []struct {
+			Effect   string `json:"effect"`
+			Language struct {
+				Name string `json:"name"`
+				URL  string `json:"url"`
+			} `json:"language"`
+		} `json:"effect_entries"`
+		VersionGroup struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"version_group"`
+	} `json:"effect_changes"`
 	FlavorEntries []struct {
 		FlavorText string `json:"flavor_text"`
 		Language   struct {
@@ -39,11 +56,12 @@ type AbilityJSONStruct struct {
 		URL  string `json:"url"`
 	} `json:"generation"`
 	Pokemon []struct {
-		Hidden      bool `json:"hidden"`
+		Hidden      bool `json:"is_hidden"`
 		PokemonName struct {
 			Name string `json:"name"`
 			URL  string `json:"url"`
 		} `json:"pokemon"`
+		Slot int `json:"slot"`
 	} `json:"pokemon"`
 }
 
@@ -60,6 +78,7 @@ type Berry struct {
 	Size             int    `db:"size"`
 	Smoothness       int    `db:"smoothness"`
 	SoilDryness      int    `db:"soil_dryness"`
+	SpriteURL        string `db:"sprite_url"`
 }
 
 // BerryFlavor represents berry flavor data from the local SQLite db
@@ -69,6 +88,23 @@ type BerryFlavor struct {
 	Potency    int    `db:"potency"`
 }
 
+// TypeEfficacy represents a type matchup from the local SQLite db. ToGen is 0
+// while the matchup still holds in the latest generation.
+type TypeEfficacy struct {
+	Attacking  string  `db:"attacking"`
+	Defending  string  `db:"defending"`
+	FromGen    int     `db:"from_gen"`
+	ToGen      int     `db:"to_gen"`
+	Multiplier float64 `db:"multiplier"`
+}
+
+// PokemonType represents a type from the local SQLite db
+type PokemonType struct {
+	Name       string `db:"name"`
+	Introduced int    `db:"introduced"`
+	TeraOnly   bool   `db:"tera_only"`
+}
+
 // ItemJSONStruct item endpoint from API
 type ItemJSONStruct struct {
 	Name     string `json:"name"`
@@ -92,6 +128,77 @@ type ItemJSONStruct struct {
 	Sprites struct {
 		Default string `json:"default"`
 	} `json:"sprites"`
+	Attributes []struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"attributes"`
+	EffectEntries []struct {
+		Effect   string `json:"effect"`
+		Language struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"language"`
+		ShortEffect string `json:"short_effect"`
+	} `json:"effect_entries"`
+	FlingEffect struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"fling_effect"`
+	FlingPower    int `json:"fling_power"`
+	HeldByPokemon []struct {
+		Pokemon struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"pokemon"`
+		VersionDetails []struct {
+			Rarity  int `json:"rarity"`
+			Version struct {
+				Name string `json:"name"`
+				URL  string `json:"url"`
+			} `json:"version"`
+		} `json:"version_details"`
+	} `json:"held_by_pokemon"`
+	Machines []struct {
+		Machine struct {
+			URL string `json:"url"`
+		} `json:"machine"`
+		VersionGroup struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"version_group"`
+	} `json:"machines"`
+}
+
+// EvolutionChainJSONStruct evolution-chain endpoint from API
+type EvolutionChainJSONStruct struct {
+	ID    int           `json:"id"`
+	Chain EvolutionLink `json:"chain"`
+}
+
+// EvolutionLink is a species in an evolution chain and what it evolves into
+type EvolutionLink struct {
+	Species struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"species"`
+	EvolvesTo []EvolutionLink `json:"evolves_to"`
+}
+
+// MachineJSONStruct machine endpoint from API
+type MachineJSONStruct struct {
+	ID   int `json:"id"`
+	Item struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"item"`
+	Move struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"move"`
+	VersionGroup struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"version_group"`
 }
 
 // MoveJSONStruct move endpoint from API
@@ -139,9 +246,23 @@ type PokemonJSONStruct struct {
 			Name string `json:"name"`
 			URL  string `json:"url"`
 		} `json:"ability"`
-		Hidden bool `json:"hidden"`
+		Hidden bool `json:"is_hidden"`
 		Slot   int  `json:"slot"`
 	} `json:"abilities"`
+	PastAbilities []struct {
+		Abilities []struct {
+			Ability struct {
+				Name string `json:"name"`
+				URL  string `json:"url"`
+			} `json:"ability"`
+			Hidden bool `json:"is_hidden"`
+			Slot   int  `json:"slot"`
+		} `json:"abilities"`
+		Generation struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"generation"`
+	} `json:"past_abilities"`
 	Cries struct {
 		Latest string `json:"latest"`
 		Legacy string `json:"legacy"`
@@ -170,10 +291,8 @@ type PokemonJSONStruct struct {
 			URL  string `json:"url"`
 		} `json:"type"`
 	} `json:"types"`
-	Sprites struct {
-		FrontDefault string `json:"front_default"`
-	} `json:"sprites"`
-	Stats []struct {
+	Sprites PokemonSprites `json:"sprites"`
+	Stats   []struct {
 		BaseStat int `json:"base_stat"`
 		Effort   int `json:"effort"`
 		Stat     struct {
@@ -186,6 +305,34 @@ type PokemonJSONStruct struct {
 	} `json:"species"`
 }
 
+// SpriteSet holds the front and back sprites of one sprite style. Any of them can be empty.
+type SpriteSet struct {
+	BackDefault      string `json:"back_default"`
+	BackFemale       string `json:"back_female"`
+	BackShiny        string `json:"back_shiny"`
+	BackShinyFemale  string `json:"back_shiny_female"`
+	FrontDefault     string `json:"front_default"`
+	FrontFemale      string `json:"front_female"`
+	FrontShiny       string `json:"front_shiny"`
+	FrontShinyFemale string `json:"front_shiny_female"`
+}
+
+// PokemonSprites sprites object from the pokemon endpoint
+type PokemonSprites struct {
+	SpriteSet
+	Other struct {
+		Home            SpriteSet `json:"home"`
+		OfficialArtwork SpriteSet `json:"official-artwork"`
+	} `json:"other"`
+	Versions struct {
+		GenerationV struct {
+			BlackWhite struct {
+				Animated SpriteSet `json:"animated"`
+			} `json:"black-white"`
+		} `json:"generation-v"`
+	} `json:"versions"`
+}
+
 // PokemonSpeciesJSONStruct pokemon-species endpoint from API
 type PokemonSpeciesJSONStruct struct {
 	Name      string `json:"name"`
@@ -197,6 +344,9 @@ type PokemonSpeciesJSONStruct struct {
 		Name string `json:"name"`
 		URL  string `json:"url"`
 	} `json:"evolves_from_species"`
+	EvolutionChain struct {
+		URL string `json:"url"`
+	} `json:"evolution_chain"`
 	FlavorTextEntries []struct {
 		FlavorText string `json:"flavor_text"`
 		Language   struct {
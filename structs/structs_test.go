@@ -10,6 +10,15 @@ import (
 func TestGetResourceName(t *testing.T) {
 	assert.Equal(t, "strong-jaw", AbilityJSONStruct{Name: "strong-jaw"}.GetResourceName())
 	assert.Equal(t, "poke-ball", ItemJSONStruct{Name: "poke-ball"}.GetResourceName())
+
+	machine := MachineJSONStruct{}
+	machine.Item.Name = "tm24"
+	assert.Equal(t, "tm24", machine.GetResourceName())
+
+	chain := EvolutionChainJSONStruct{ID: 46}
+	chain.Chain.Species.Name = "gastly"
+	assert.Equal(t, "gastly", chain.GetResourceName())
+
 	assert.Equal(t, "thunderbolt", MoveJSONStruct{Name: "thunderbolt"}.GetResourceName())
 	assert.Equal(t, "pikachu", PokemonJSONStruct{Name: "pikachu"}.GetResourceName())
 	assert.Equal(t, "pikachu", PokemonSpeciesJSONStruct{Name: "pikachu"}.GetResourceName())
@@ -29,7 +38,7 @@ func TestPokemonJSONStruct_Unmarshal(t *testing.T) {
 					"name": "static",
 					"url": "https://pokeapi.co/api/v2/ability/9/"
 				},
-				"hidden": false,
+				"is_hidden": false,
 				"slot": 1
 			}
 		],
@@ -43,7 +52,23 @@ func TestPokemonJSONStruct_Unmarshal(t *testing.T) {
 			}
 		],
 		"sprites": {
-			"front_default": "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/pokemon/25.png"
+			"front_default": "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/pokemon/25.png",
+			"front_shiny": "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/pokemon/shiny/25.png",
+			"front_female": "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/pokemon/female/25.png",
+			"other": {
+				"official-artwork": {
+					"front_default": "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/pokemon/other/official-artwork/25.png"
+				}
+			},
+			"versions": {
+				"generation-v": {
+					"black-white": {
+						"animated": {
+							"back_default": "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/pokemon/versions/generation-v/black-white/animated/back/25.gif"
+						}
+					}
+				}
+			}
 		},
 		"stats": [
 			{
@@ -77,4 +102,13 @@ func TestPokemonJSONStruct_Unmarshal(t *testing.T) {
 	if pokemon.Sprites.FrontDefault == "" {
 		t.Errorf("Expected a sprite URL but got an empty string")
 	}
+	if pokemon.Sprites.FrontShiny == "" || pokemon.Sprites.FrontFemale == "" {
+		t.Errorf("Expected shiny and female sprite URLs, got %+v", pokemon.Sprites.SpriteSet)
+	}
+	if pokemon.Sprites.Other.OfficialArtwork.FrontDefault == "" {
+		t.Errorf("Expected an official artwork URL but got an empty string")
+	}
+	if pokemon.Sprites.Versions.GenerationV.BlackWhite.Animated.BackDefault == "" {
+		t.Errorf("Expected an animated back sprite URL but got an empty string")
+	}
 }
@@ -0,0 +1,53 @@
+package typechart
+
+import "strings"
+
+// AbilityImmunities are abilities that make their holder immune to types.
+var AbilityImmunities = map[string][]string{
+	"flash-fire":    {"fire"},
+	"water-absorb":  {"water"},
+	"storm-drain":   {"water"},
+	"volt-absorb":   {"electric"},
+	"motor-drive":   {"electric"},
+	"lightning-rod": {"electric"},
+	"sap-sipper":    {"grass"},
+	"dry-skin":      {"water"},
+	"levitate":      {"ground"},
+	"earth-eater":   {"ground"},
+}
+
+// AbilityResistances are abilities that halve the damage of types.
+var AbilityResistances = map[string][]string{
+	"thick-fat": {"fire", "ice"},
+	"heatproof": {"fire"},
+}
+
+// NormalizeAbility turns "Thick Fat" or "thick_fat" into "thick-fat".
+func NormalizeAbility(ability string) string {
+	ability = strings.ToLower(strings.TrimSpace(ability))
+	return strings.NewReplacer(" ", "-", "_", "-").Replace(ability)
+}
+
+// HasAbility reports whether an ability changes type matchups.
+func HasAbility(ability string) bool {
+	ability = NormalizeAbility(ability)
+	_, immune := AbilityImmunities[ability]
+	_, resists := AbilityResistances[ability]
+	return immune || resists
+}
+
+// AbilityModifier is what an ability multiplies an attacking type's damage by.
+func AbilityModifier(ability, attacking string) float64 {
+	ability, attacking = NormalizeAbility(ability), strings.ToLower(attacking)
+	for _, t := range AbilityImmunities[ability] {
+		if t == attacking {
+			return 0
+		}
+	}
+	for _, t := range AbilityResistances[ability] {
+		if t == attacking {
+			return 0.5
+		}
+	}
+	return 1
+}
@@ -0,0 +1,27 @@
+package typechart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAbility(t *testing.T) {
+	assert.Equal(t, "thick-fat", NormalizeAbility("Thick Fat"))
+	assert.Equal(t, "thick-fat", NormalizeAbility(" thick_fat "))
+	assert.Equal(t, "levitate", NormalizeAbility("LEVITATE"))
+}
+
+func TestHasAbility(t *testing.T) {
+	assert.True(t, HasAbility("Levitate"))
+	assert.True(t, HasAbility("heatproof"))
+	assert.False(t, HasAbility("intimidate"))
+	assert.False(t, HasAbility(""))
+}
+
+func TestAbilityModifier(t *testing.T) {
+	assert.Equal(t, 0.0, AbilityModifier("levitate", "Ground"))
+	assert.Equal(t, 0.5, AbilityModifier("Thick Fat", "ice"))
+	assert.Equal(t, 1.0, AbilityModifier("thick-fat", "water"))
+	assert.Equal(t, 1.0, AbilityModifier("", "ground"))
+}
@@ -0,0 +1,176 @@
+// Package typechart answers type matchup questions from the embedded type
+// chart, so type math needs no network calls.
+package typechart
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/digitalghost-dev/poke-cli/connections"
+	"github.com/digitalghost-dev/poke-cli/structs"
+)
+
+const (
+	// LatestGen is the newest generation in the chart.
+	LatestGen = 9
+	// Stellar is the Tera type that keeps a Pokémon's original types on
+	// defense and hits terastallized Pokémon for double damage.
+	Stellar = "stellar"
+)
+
+// Chart is the type chart of one generation.
+type Chart struct {
+	Gen         int
+	types       []string
+	teraOnly    []string
+	multipliers map[[2]string]float64
+}
+
+var (
+	chartsMu sync.Mutex
+	charts   = map[int]*Chart{}
+)
+
+// ForGen loads the chart of a generation: Gen 1 has no Dark, Steel or Fairy
+// and a few different matchups, Gens 2-5 have no Fairy and Steel resists Ghost
+// and Dark, and Gen 9 adds the Stellar Tera type.
+func ForGen(gen int) (*Chart, error) {
+	if gen < 1 || gen > LatestGen {
+		return nil, fmt.Errorf("generation must be between 1 and %d", LatestGen)
+	}
+
+	chartsMu.Lock()
+	defer chartsMu.Unlock()
+	if c, ok := charts[gen]; ok {
+		return c, nil
+	}
+
+	types, err := connections.Query[structs.PokemonType](connections.TypeChart, `
+		SELECT
+			name, introduced, tera_only
+		FROM
+			types
+		WHERE
+			introduced <= ?
+		ORDER BY
+			rowid`, gen)
+	if err != nil {
+		return nil, err
+	}
+	efficacy, err := connections.Query[structs.TypeEfficacy](connections.TypeChart, `
+		SELECT
+			attacking, defending, from_gen, to_gen, multiplier
+		FROM
+			efficacy
+		WHERE
+			from_gen <= ?1 AND (to_gen IS NULL OR to_gen >= ?1)`, gen)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Chart{Gen: gen, multipliers: map[[2]string]float64{}}
+	for _, t := range types {
+		if t.TeraOnly {
+			c.teraOnly = append(c.teraOnly, t.Name)
+			continue
+		}
+		c.types = append(c.types, t.Name)
+	}
+	for _, e := range efficacy {
+		c.multipliers[[2]string{e.Attacking, e.Defending}] = e.Multiplier
+	}
+	charts[gen] = c
+	return c, nil
+}
+
+// Latest is the chart of LatestGen.
+func Latest() (*Chart, error) {
+	return ForGen(LatestGen)
+}
+
+// Types lists the chart's types in index order, without Tera-only types.
+func (c *Chart) Types() []string {
+	return slices.Clone(c.types)
+}
+
+// Has reports whether a type exists in the chart's generation. Tera-only
+// types count only when tera is set.
+func (c *Chart) Has(name string, tera bool) bool {
+	name = strings.ToLower(name)
+	return slices.Contains(c.types, name) || (tera && slices.Contains(c.teraOnly, name))
+}
+
+// Multiplier is one attacking type against one defending type.
+func (c *Chart) Multiplier(attacking, defending string) float64 {
+	if m, ok := c.multipliers[[2]string{strings.ToLower(attacking), strings.ToLower(defending)}]; ok {
+		return m
+	}
+	return 1
+}
+
+// Effectiveness is the combined multiplier of an attacking type against
+// every defending type.
+func (c *Chart) Effectiveness(attacking string, defending ...string) float64 {
+	total := 1.0
+	for _, d := range defending {
+		total *= c.Multiplier(attacking, d)
+	}
+	return total
+}
+
+// TeraEffectiveness is Effectiveness against a terastallized Pokémon. It
+// defends as its Tera type alone, except Stellar, which keeps the original
+// types. Stellar attacks hit any terastallized Pokémon for double damage.
+func (c *Chart) TeraEffectiveness(attacking, tera string, defending ...string) float64 {
+	attacking, tera = strings.ToLower(attacking), strings.ToLower(tera)
+	if tera == "" {
+		return c.Effectiveness(attacking, defending...)
+	}
+	if attacking == Stellar {
+		return 2
+	}
+	if tera == Stellar {
+		return c.Effectiveness(attacking, defending...)
+	}
+	return c.Effectiveness(attacking, tera)
+}
+
+// Attacking lists the types an attacking type hits for the given multiplier.
+func (c *Chart) Attacking(attacking string, multiplier float64) []string {
+	var types []string
+	for _, d := range c.types {
+		if c.Multiplier(attacking, d) == multiplier {
+			types = append(types, d)
+		}
+	}
+	return types
+}
+
+// Defending lists the attacking types that hit the defending types for the
+// given combined multiplier.
+func (c *Chart) Defending(multiplier float64, defending ...string) []string {
+	var types []string
+	for _, a := range c.types {
+		if c.Effectiveness(a, defending...) == multiplier {
+			types = append(types, a)
+		}
+	}
+	return types
+}
+
+// Effectiveness is the latest generation's multiplier of an attacking type
+// against the defending types.
+func Effectiveness(attacking string, defending ...string) (float64, error) {
+	c, err := Latest()
+	if err != nil {
+		return 0, err
+	}
+	for _, t := range append([]string{attacking}, defending...) {
+		if !c.Has(t, false) {
+			return 0, fmt.Errorf("unknown type %q", t)
+		}
+	}
+	return c.Effectiveness(attacking, defending...), nil
+}
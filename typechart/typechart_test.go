@@ -0,0 +1,124 @@
+package typechart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForGen_Types(t *testing.T) {
+	tests := []struct {
+		gen   int
+		count int
+		has   string
+		lacks string
+	}{
+		{1, 15, "dragon", "dark"},
+		{2, 17, "steel", "fairy"},
+		{5, 17, "dark", "fairy"},
+		{6, 18, "fairy", Stellar},
+		{9, 18, "fairy", Stellar},
+	}
+	for _, tt := range tests {
+		c, err := ForGen(tt.gen)
+		require.NoError(t, err)
+		assert.Len(t, c.Types(), tt.count, "gen %d", tt.gen)
+		assert.True(t, c.Has(tt.has, false), "gen %d has %s", tt.gen, tt.has)
+		assert.False(t, c.Has(tt.lacks, false), "gen %d lacks %s", tt.gen, tt.lacks)
+	}
+
+	latest, err := Latest()
+	require.NoError(t, err)
+	assert.True(t, latest.Has("Stellar", true), "Stellar is a Tera type in Gen 9")
+
+	_, err = ForGen(0)
+	assert.Error(t, err)
+	_, err = ForGen(LatestGen + 1)
+	assert.Error(t, err)
+}
+
+func TestForGen_Cached(t *testing.T) {
+	a, err := ForGen(4)
+	require.NoError(t, err)
+	b, err := ForGen(4)
+	require.NoError(t, err)
+	assert.Same(t, a, b)
+}
+
+func TestMultiplier_GenerationChanges(t *testing.T) {
+	tests := []struct {
+		attacking, defending string
+		gen                  int
+		want                 float64
+	}{
+		{"ghost", "psychic", 1, 0},
+		{"ghost", "psychic", 2, 2},
+		{"bug", "poison", 1, 2},
+		{"bug", "poison", 3, 0.5},
+		{"poison", "bug", 1, 2},
+		{"poison", "bug", 2, 1},
+		{"ice", "fire", 1, 1},
+		{"ice", "fire", 2, 0.5},
+		{"ghost", "steel", 5, 0.5},
+		{"ghost", "steel", 6, 1},
+		{"dark", "steel", 4, 0.5},
+		{"dark", "steel", 9, 1},
+		{"dragon", "fairy", 9, 0},
+		{"normal", "ghost", 1, 0},
+	}
+	for _, tt := range tests {
+		c, err := ForGen(tt.gen)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, c.Multiplier(tt.attacking, tt.defending), "gen %d %s → %s", tt.gen, tt.attacking, tt.defending)
+	}
+}
+
+func TestEffectiveness(t *testing.T) {
+	got, err := Effectiveness("ice", "dragon", "ground")
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, got)
+
+	got, err = Effectiveness("Fire", "Water", "Rock")
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, got)
+
+	got, err = Effectiveness("ground", "steel", "flying")
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, got)
+
+	got, err = Effectiveness("normal")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, got, "no defending types is neutral")
+
+	_, err = Effectiveness("sound", "fire")
+	assert.ErrorContains(t, err, `unknown type "sound"`)
+	_, err = Effectiveness(Stellar, "fire")
+	assert.Error(t, err, "Stellar only exists as a Tera type")
+}
+
+func TestTeraEffectiveness(t *testing.T) {
+	c, err := Latest()
+	require.NoError(t, err)
+
+	// Dragonite is 4x weak to ice until it terastallizes
+	assert.Equal(t, 4.0, c.TeraEffectiveness("ice", "", "dragon", "flying"))
+	assert.Equal(t, 1.0, c.TeraEffectiveness("ice", "normal", "dragon", "flying"))
+	assert.Equal(t, 4.0, c.TeraEffectiveness("ice", Stellar, "dragon", "flying"))
+	assert.Equal(t, 2.0, c.TeraEffectiveness(Stellar, "normal", "dragon", "flying"))
+	assert.Equal(t, 1.0, c.TeraEffectiveness(Stellar, "", "dragon", "flying"))
+}
+
+func TestAttackingDefending(t *testing.T) {
+	c, err := Latest()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"grass", "ice", "bug", "steel"}, c.Attacking("fire", 2))
+	assert.Equal(t, []string{"ghost"}, c.Attacking("normal", 0))
+	assert.Equal(t, []string{"fighting", "ground"}, c.Defending(4, "steel", "rock"))
+	assert.Equal(t, []string{"normal", "fighting"}, c.Defending(0, "ghost"))
+
+	gen1, err := ForGen(1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fire", "grass", "bug"}, gen1.Defending(0.5, "fire"), "Ice isn't resisted by Fire in Gen 1")
+}